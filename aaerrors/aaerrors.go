@@ -0,0 +1,197 @@
+// Package aaerrors decodes the ERC-4337 JSON-RPC errors returned by bundlers
+// into typed values:
+//
+//	_, err := c.SendUserOperation(ctx, op, entryPoint)
+//	if e, ok := aaerrors.Parse(err); ok && errors.Is(e, aaerrors.ErrPaymasterRejected) {
+//		log.Printf("paymaster rejected op: %s (%s)", e.Reason, e.AACode)
+//	}
+package aaerrors
+
+import (
+	"encoding/json"
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// ERC-4337 bundler RPC error codes.
+const (
+	CodeInvalidParams         = -32602
+	CodeSimulationRejected    = -32500
+	CodePaymasterRejected     = -32501
+	CodeOpcodeViolation       = -32502
+	CodeTimeRange             = -32503
+	CodeEntityThrottled       = -32504
+	CodeInsufficientStake     = -32505
+	CodeUnsupportedAggregator = -32506
+	CodeSignatureValidation   = -32507
+	CodeInsufficientPaymaster = -32508
+	// CodeExecutionReverted was added by ERC-7769 for ops whose execution
+	// phase reverts during estimation.
+	CodeExecutionReverted = -32521
+)
+
+var (
+	ErrInvalidParams         = errors.New("invalid userop fields")
+	ErrSimulationRejected    = errors.New("rejected by entrypoint simulation")
+	ErrPaymasterRejected     = errors.New("rejected by paymaster")
+	ErrOpcodeViolation       = errors.New("banned opcode or storage access")
+	ErrTimeRange             = errors.New("outside of valid time range")
+	ErrEntityThrottled       = errors.New("entity throttled or banned")
+	ErrInsufficientStake     = errors.New("entity stake or unstake delay too low")
+	ErrUnsupportedAggregator = errors.New("unsupported signature aggregator")
+	ErrSignatureValidation   = errors.New("signature validation failed")
+	ErrInsufficientPaymaster = errors.New("paymaster deposit too low for pending ops")
+	ErrExecutionReverted     = errors.New("execution reverted")
+)
+
+var sentinels = map[int]error{
+	CodeInvalidParams:         ErrInvalidParams,
+	CodeSimulationRejected:    ErrSimulationRejected,
+	CodePaymasterRejected:     ErrPaymasterRejected,
+	CodeOpcodeViolation:       ErrOpcodeViolation,
+	CodeTimeRange:             ErrTimeRange,
+	CodeEntityThrottled:       ErrEntityThrottled,
+	CodeInsufficientStake:     ErrInsufficientStake,
+	CodeUnsupportedAggregator: ErrUnsupportedAggregator,
+	CodeSignatureValidation:   ErrSignatureValidation,
+	CodeInsufficientPaymaster: ErrInsufficientPaymaster,
+	CodeExecutionReverted:     ErrExecutionReverted,
+}
+
+// Failures that bundlers report under differing codes, recognized from the
+// AAxx code or message instead.
+var (
+	ErrAlreadyKnown           = errors.New("userop already known")
+	ErrReplacementUnderpriced = errors.New("replacement userop underpriced")
+	ErrInvalidSignature       = errors.New("invalid signature")
+	ErrPaymasterDepositTooLow = errors.New("paymaster deposit too low")
+)
+
+var aaCodeSentinels = map[string]error{
+	"AA24": ErrInvalidSignature,
+	"AA34": ErrInvalidSignature,
+	"AA31": ErrPaymasterDepositTooLow,
+}
+
+var messageSentinels = []struct {
+	substr string
+	err    error
+}{
+	{"already known", ErrAlreadyKnown},
+	{"already in mempool", ErrAlreadyKnown},
+	{"already exists", ErrAlreadyKnown},
+	{"replacement underpriced", ErrReplacementUnderpriced},
+	{"replacementunderpriced", ErrReplacementUnderpriced},
+	{"replacement op must increase", ErrReplacementUnderpriced},
+	{"invalid signature", ErrInvalidSignature},
+	{"signature error", ErrInvalidSignature},
+	{"deposit too low", ErrPaymasterDepositTooLow},
+}
+
+var aaCodePattern = regexp.MustCompile(`\bAA[0-9]{2}\b`)
+
+// Error is a decoded bundler error. AACode is the EntryPoint's AAxx code
+// found in the message, if any, and Reason the message text following it.
+// Paymaster and Aggregator are set from the error data when the bundler
+// reports the offending entity.
+type Error struct {
+	Code       int
+	Message    string
+	AACode     string
+	Reason     string
+	Paymaster  *common.Address
+	Aggregator *common.Address
+	// Data is the raw error data, typically a JSON object.
+	Data interface{}
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the sentinels for the error code, AAxx code and message,
+// so that errors.Is matches any of them.
+func (e *Error) Unwrap() []error {
+	var errs []error
+	if err, ok := sentinels[e.Code]; ok {
+		errs = append(errs, err)
+	}
+	if e.Code == CodeSignatureValidation {
+		errs = append(errs, ErrInvalidSignature)
+	}
+	if err, ok := aaCodeSentinels[e.AACode]; ok {
+		errs = append(errs, err)
+	}
+	msg := strings.ToLower(e.Message)
+	for _, s := range messageSentinels {
+		if strings.Contains(msg, s.substr) {
+			errs = append(errs, s.err)
+		}
+	}
+	return errs
+}
+
+// Entity returns the kind of entity an AAxx code blames: "factory" (AA1x),
+// "account" (AA2x), "paymaster" (AA3x), or "" for other codes.
+func (e *Error) Entity() string {
+	if len(e.AACode) != 4 {
+		return ""
+	}
+	switch e.AACode[2] {
+	case '1':
+		return "factory"
+	case '2':
+		return "account"
+	case '3':
+		return "paymaster"
+	}
+	return ""
+}
+
+// Parse decodes err if it is a JSON-RPC error, returning false otherwise.
+func Parse(err error) (*Error, bool) {
+	var rpcErr rpc.Error
+	if !errors.As(err, &rpcErr) {
+		return nil, false
+	}
+	e := &Error{Code: rpcErr.ErrorCode(), Message: rpcErr.Error()}
+	if loc := aaCodePattern.FindStringIndex(e.Message); loc != nil {
+		e.AACode = e.Message[loc[0]:loc[1]]
+		e.Reason = strings.TrimSpace(e.Message[loc[1]:])
+	}
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		e.Data = dataErr.ErrorData()
+		e.Paymaster, e.Aggregator = entities(e.Data)
+	}
+	return e, true
+}
+
+// Is reports whether err maps onto the given sentinel, so that callers need
+// not Parse the error first.
+func Is(err, target error) bool {
+	if e, ok := Parse(err); ok {
+		return errors.Is(e, target)
+	}
+	return false
+}
+
+// entities extracts the entities named in the error data.
+func entities(data interface{}) (paymaster, aggregator *common.Address) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, nil
+	}
+	var dec struct {
+		Paymaster  *common.Address `json:"paymaster"`
+		Aggregator *common.Address `json:"aggregator"`
+	}
+	if json.Unmarshal(b, &dec) != nil {
+		return nil, nil
+	}
+	return dec.Paymaster, dec.Aggregator
+}
@@ -0,0 +1,66 @@
+package bundler_client
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	ErrInvalidAddress  = errors.New("invalid address")
+	ErrInvalidChecksum = errors.New("invalid EIP-55 address checksum")
+)
+
+type ChecksumMode int
+
+const (
+	// ChecksumIgnore accepts any well-formed hex address.
+	ChecksumIgnore ChecksumMode = iota
+	// ChecksumWarn accepts addresses with a bad checksum but reports them to
+	// the policy's Warn callback.
+	ChecksumWarn
+	// ChecksumEnforce rejects mixed-case addresses with a bad checksum.
+	ChecksumEnforce
+)
+
+// ChecksumPolicy validates user-supplied address strings (entrypoint, sender,
+// paymaster) against their EIP-55 checksum. Addresses written entirely in
+// lower or upper case carry no checksum and are always accepted.
+type ChecksumPolicy struct {
+	Mode ChecksumMode
+	Warn func(input string, err error)
+}
+
+// Parse decodes s into an address, validating its checksum per the policy.
+func (p ChecksumPolicy) Parse(s string) (common.Address, error) {
+	if !common.IsHexAddress(s) {
+		return common.Address{}, fmt.Errorf("%w: %q", ErrInvalidAddress, s)
+	}
+	addr := common.HexToAddress(s)
+	if p.Mode == ChecksumIgnore || validChecksum(s, addr) {
+		return addr, nil
+	}
+	err := fmt.Errorf("%w: %q, expected %s", ErrInvalidChecksum, s, addr.Hex())
+	if p.Mode == ChecksumEnforce {
+		return common.Address{}, err
+	}
+	if p.Warn != nil {
+		p.Warn(s, err)
+	}
+	return addr, nil
+}
+
+// ParseAddress decodes s into an address, rejecting bad EIP-55 checksums.
+func ParseAddress(s string) (common.Address, error) {
+	return ChecksumPolicy{Mode: ChecksumEnforce}.Parse(s)
+}
+
+func validChecksum(s string, addr common.Address) bool {
+	hex := strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	if hex == strings.ToLower(hex) || hex == strings.ToUpper(hex) {
+		return true
+	}
+	return hex == addr.Hex()[2:]
+}
@@ -0,0 +1,96 @@
+package bundler_client
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const aggregatorABI = `[
+	{"type":"function","name":"validateUserOpSignature","stateMutability":"view","inputs":[{"name":"userOp","type":"tuple","components":[
+		{"name":"sender","type":"address"},{"name":"nonce","type":"uint256"},{"name":"initCode","type":"bytes"},{"name":"callData","type":"bytes"},
+		{"name":"callGasLimit","type":"uint256"},{"name":"verificationGasLimit","type":"uint256"},{"name":"preVerificationGas","type":"uint256"},
+		{"name":"maxFeePerGas","type":"uint256"},{"name":"maxPriorityFeePerGas","type":"uint256"},{"name":"paymasterAndData","type":"bytes"},{"name":"signature","type":"bytes"}]}],
+		"outputs":[{"name":"sigForUserOp","type":"bytes"}]},
+	{"type":"function","name":"aggregateSignatures","stateMutability":"view","inputs":[{"name":"userOps","type":"tuple[]","components":[
+		{"name":"sender","type":"address"},{"name":"nonce","type":"uint256"},{"name":"initCode","type":"bytes"},{"name":"callData","type":"bytes"},
+		{"name":"callGasLimit","type":"uint256"},{"name":"verificationGasLimit","type":"uint256"},{"name":"preVerificationGas","type":"uint256"},
+		{"name":"maxFeePerGas","type":"uint256"},{"name":"maxPriorityFeePerGas","type":"uint256"},{"name":"paymasterAndData","type":"bytes"},{"name":"signature","type":"bytes"}]}],
+		"outputs":[{"name":"aggregatedSignature","type":"bytes"}]}
+]`
+
+var aggregatorContract, _ = abi.JSON(strings.NewReader(aggregatorABI))
+
+// SignatureAggregator builds signatures for accounts validated by a v0.6
+// signature aggregator (IAggregator), such as a BLS aggregator.
+type SignatureAggregator interface {
+	// Address is the aggregator contract.
+	Address() common.Address
+	// DummySignature returns a signature of the aggregator's format, valid
+	// enough for op to be estimated.
+	DummySignature(op *UserOperation) []byte
+	// ValidateUserOpSignature returns the signature to carry in op once it
+	// has been signed for aggregation.
+	ValidateUserOpSignature(ctx context.Context, op *UserOperation) ([]byte, error)
+	// AggregateSignatures combines the signatures of ops into one.
+	AggregateSignatures(ctx context.Context, ops []*UserOperation) ([]byte, error)
+}
+
+// ContractAggregator implements SignatureAggregator by eth_calling the
+// aggregator contract. DummySig is returned by DummySignature.
+type ContractAggregator struct {
+	Backend    ethereum.ContractCaller
+	Aggregator common.Address
+	DummySig   []byte
+}
+
+func NewContractAggregator(backend ethereum.ContractCaller, aggregator common.Address, dummySig []byte) *ContractAggregator {
+	return &ContractAggregator{Backend: backend, Aggregator: aggregator, DummySig: dummySig}
+}
+
+func (a *ContractAggregator) Address() common.Address {
+	return a.Aggregator
+}
+
+func (a *ContractAggregator) DummySignature(*UserOperation) []byte {
+	return a.DummySig
+}
+
+func (a *ContractAggregator) call(ctx context.Context, method string, args ...interface{}) ([]byte, error) {
+	input, err := aggregatorContract.Pack(method, args...)
+	if err != nil {
+		return nil, err
+	}
+	out, err := a.Backend.CallContract(ctx, ethereum.CallMsg{To: &a.Aggregator, Data: input}, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := aggregatorContract.Unpack(method, out)
+	if err != nil {
+		return nil, err
+	}
+	return res[0].([]byte), nil
+}
+
+func (a *ContractAggregator) ValidateUserOpSignature(ctx context.Context, op *UserOperation) ([]byte, error) {
+	return a.call(ctx, "validateUserOpSignature", op)
+}
+
+func (a *ContractAggregator) AggregateSignatures(ctx context.Context, ops []*UserOperation) ([]byte, error) {
+	values := make([]UserOperation, len(ops))
+	for i, op := range ops {
+		values[i] = *op
+	}
+	return a.call(ctx, "aggregateSignatures", values)
+}
+
+// EstimateAggregatedUserOperationGas estimates op with the aggregator's
+// dummy signature in place of its own, leaving op untouched.
+func EstimateAggregatedUserOperationGas(ctx context.Context, c EthClient, agg SignatureAggregator, op *UserOperation, entryPoint common.Address) (*GasEstimates, error) {
+	tmp := *op
+	tmp.Signature = agg.DummySignature(op)
+	return c.EstimateUserOperationGas(ctx, &tmp, entryPoint)
+}
@@ -0,0 +1,97 @@
+package bundler_client
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// AlchemyClient exposes the rundler_* and alchemy_* extension methods of
+// Alchemy's bundler (Rundler) and Gas Manager.
+type AlchemyClient struct {
+	c *RpcClient
+}
+
+// Alchemy returns the Alchemy extension client for c.
+func (c *RpcClient) Alchemy() *AlchemyClient {
+	return &AlchemyClient{c: c}
+}
+
+// MaxPriorityFeePerGas returns the minimum priority fee Rundler currently
+// accepts for ops.
+func (c *AlchemyClient) MaxPriorityFeePerGas(ctx context.Context) (*big.Int, error) {
+	var result hexutil.Big
+	err := c.c.call(ctx, &result, "rundler_maxPriorityFeePerGas", []interface{}{}...)
+	if err != nil {
+		return nil, err
+	}
+	return result.ToInt(), nil
+}
+
+// GasAndPaymasterRequest is the request of
+// alchemy_requestGasAndPaymasterAndData. Only the sender, nonce, initCode
+// and callData of UserOperation are sent; Alchemy fills in the rest.
+// Overrides optionally pins gas fields, e.g. {"maxFeePerGas": "0x..."}.
+type GasAndPaymasterRequest struct {
+	PolicyId       string
+	EntryPoint     common.Address
+	DummySignature []byte
+	UserOperation  *UserOperation
+	Overrides      map[string]interface{}
+}
+
+// GasAndPaymasterResult is the result of
+// alchemy_requestGasAndPaymasterAndData.
+type GasAndPaymasterResult struct {
+	PaymasterAndData     hexutil.Bytes `json:"paymasterAndData"`
+	CallGasLimit         *hexutil.Big  `json:"callGasLimit"`
+	VerificationGasLimit *hexutil.Big  `json:"verificationGasLimit"`
+	PreVerificationGas   *hexutil.Big  `json:"preVerificationGas"`
+	MaxFeePerGas         *hexutil.Big  `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big  `json:"maxPriorityFeePerGas"`
+}
+
+// Apply patches the paymaster data, gas limits and fees into op, which must
+// then be signed.
+func (r *GasAndPaymasterResult) Apply(op *UserOperation) {
+	op.PaymasterAndData = r.PaymasterAndData
+	op.CallGasLimit = r.CallGasLimit.ToInt()
+	op.VerificationGasLimit = r.VerificationGasLimit.ToInt()
+	op.PreVerificationGas = r.PreVerificationGas.ToInt()
+	op.MaxFeePerGas = r.MaxFeePerGas.ToInt()
+	op.MaxPriorityFeePerGas = r.MaxPriorityFeePerGas.ToInt()
+}
+
+// RequestGasAndPaymasterAndData estimates gas and fees for req.UserOperation
+// and requests sponsorship under req.PolicyId in one call, patching the
+// result into req.UserOperation.
+func (c *AlchemyClient) RequestGasAndPaymasterAndData(ctx context.Context, req *GasAndPaymasterRequest) (*GasAndPaymasterResult, error) {
+	op := req.UserOperation
+	type partialOp struct {
+		Sender   common.Address `json:"sender"`
+		Nonce    *hexutil.Big   `json:"nonce"`
+		InitCode hexutil.Bytes  `json:"initCode"`
+		CallData hexutil.Bytes  `json:"callData"`
+	}
+	params := struct {
+		PolicyId       string                 `json:"policyId"`
+		EntryPoint     common.Address         `json:"entryPoint"`
+		DummySignature hexutil.Bytes          `json:"dummySignature"`
+		UserOperation  partialOp              `json:"userOperation"`
+		Overrides      map[string]interface{} `json:"overrides,omitempty"`
+	}{
+		PolicyId:       req.PolicyId,
+		EntryPoint:     req.EntryPoint,
+		DummySignature: req.DummySignature,
+		UserOperation:  partialOp{op.Sender, (*hexutil.Big)(op.Nonce), op.InitCode, op.CallData},
+		Overrides:      req.Overrides,
+	}
+	var result GasAndPaymasterResult
+	if err := c.c.call(ctx, &result, "alchemy_requestGasAndPaymasterAndData", params); err != nil {
+		return nil, err
+	}
+	result.Apply(op)
+	return &result, nil
+}
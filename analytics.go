@@ -0,0 +1,120 @@
+package bundler_client
+
+import (
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// FeePercentiles summarizes a fee distribution, in wei.
+type FeePercentiles struct {
+	Min *big.Int `json:"min"`
+	P50 *big.Int `json:"p50"`
+	P90 *big.Int `json:"p90"`
+	P99 *big.Int `json:"p99"`
+	Max *big.Int `json:"max"`
+}
+
+func feePercentiles(values []*big.Int) FeePercentiles {
+	if len(values) == 0 {
+		return FeePercentiles{}
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i].Cmp(values[j]) < 0 })
+	p := func(q float64) *big.Int {
+		return values[int(q*float64(len(values)-1))]
+	}
+	return FeePercentiles{Min: values[0], P50: p(0.5), P90: p(0.9), P99: p(0.99), Max: values[len(values)-1]}
+}
+
+// AgeBucket counts pending ops whose age is below UpTo (and at least the
+// previous bucket's UpTo). The final bucket has UpTo == 0 and counts all
+// older ops.
+type AgeBucket struct {
+	UpTo  time.Duration `json:"upTo"`
+	Count int           `json:"count"`
+}
+
+var DefaultAgeBuckets = []time.Duration{30 * time.Second, time.Minute, 5 * time.Minute, 30 * time.Minute}
+
+type MempoolReport struct {
+	Time                 time.Time              `json:"time"`
+	Total                int                    `json:"total"`
+	BySender             map[common.Address]int `json:"bySender"`
+	ByPaymaster          map[common.Address]int `json:"byPaymaster"`
+	ByFactory            map[common.Address]int `json:"byFactory"`
+	MaxFeePerGas         FeePercentiles         `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas FeePercentiles         `json:"maxPriorityFeePerGas"`
+	Ages                 []AgeBucket            `json:"ages"`
+	OldestAge            time.Duration          `json:"oldestAge"`
+}
+
+// MempoolAnalyzer aggregates successive debug_bundler_dumpMempool snapshots
+// into typed reports. Since dump entries carry no submission time, an op's
+// age is measured from the first snapshot it was seen in.
+type MempoolAnalyzer struct {
+	Buckets []time.Duration
+
+	mu        sync.Mutex
+	firstSeen map[opKey]time.Time
+}
+
+func NewMempoolAnalyzer() *MempoolAnalyzer {
+	return &MempoolAnalyzer{Buckets: DefaultAgeBuckets, firstSeen: make(map[opKey]time.Time)}
+}
+
+// Analyze produces a report for the given snapshot taken at now.
+func (a *MempoolAnalyzer) Analyze(ops []*UserOperation, now time.Time) *MempoolReport {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	r := &MempoolReport{
+		Time:        now,
+		Total:       len(ops),
+		BySender:    make(map[common.Address]int),
+		ByPaymaster: make(map[common.Address]int),
+		ByFactory:   make(map[common.Address]int),
+	}
+	r.Ages = make([]AgeBucket, len(a.Buckets)+1)
+	for i, b := range a.Buckets {
+		r.Ages[i].UpTo = b
+	}
+
+	var maxFees, tips []*big.Int
+	seen := make(map[opKey]time.Time, len(ops))
+	for _, op := range ops {
+		r.BySender[op.Sender]++
+		if pm := op.GetPaymaster(); pm != (common.Address{}) {
+			r.ByPaymaster[pm]++
+		}
+		if f := op.GetFactory(); f != (common.Address{}) {
+			r.ByFactory[f]++
+		}
+		if op.MaxFeePerGas != nil {
+			maxFees = append(maxFees, op.MaxFeePerGas)
+		}
+		if op.MaxPriorityFeePerGas != nil {
+			tips = append(tips, op.MaxPriorityFeePerGas)
+		}
+
+		key := opKey{op.Sender, op.Nonce.String()}
+		first, ok := a.firstSeen[key]
+		if !ok {
+			first = now
+		}
+		seen[key] = first
+		age := now.Sub(first)
+		if age > r.OldestAge {
+			r.OldestAge = age
+		}
+		i := sort.Search(len(a.Buckets), func(i int) bool { return age < a.Buckets[i] })
+		r.Ages[i].Count++
+	}
+	a.firstSeen = seen
+
+	r.MaxFeePerGas = feePercentiles(maxFees)
+	r.MaxPriorityFeePerGas = feePercentiles(tips)
+	return r
+}
@@ -0,0 +1,88 @@
+package bundler_client
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NodeInterfaceAddress is the Arbitrum Nitro NodeInterface virtual contract,
+// only reachable through eth_call and eth_estimateGas.
+var NodeInterfaceAddress = common.HexToAddress("0x00000000000000000000000000000000000000C8")
+
+const nodeInterfaceABI = `[
+	{"type":"function","name":"gasEstimateL1Component","stateMutability":"payable","inputs":[{"name":"to","type":"address"},{"name":"contractCreation","type":"bool"},{"name":"data","type":"bytes"}],"outputs":[{"name":"gasEstimateForL1","type":"uint64"},{"name":"baseFee","type":"uint256"},{"name":"l1BaseFeeEstimate","type":"uint256"}]}
+]`
+
+var nodeInterface, _ = abi.JSON(strings.NewReader(nodeInterfaceABI))
+
+// ArbitrumL1Component is the L1 share of an Arbitrum transaction's cost.
+// GasEstimateForL1 is denominated in L2 gas, so it adds directly to
+// preVerificationGas.
+type ArbitrumL1Component struct {
+	GasEstimateForL1  uint64
+	BaseFee           *big.Int
+	L1BaseFeeEstimate *big.Int
+}
+
+// Fee returns the L1 component in wei at the L2 base fee.
+func (c *ArbitrumL1Component) Fee() *big.Int {
+	return new(big.Int).Mul(new(big.Int).SetUint64(c.GasEstimateForL1), c.BaseFee)
+}
+
+// ArbitrumL1GasEstimator estimates the L1 component of Arbitrum transactions
+// via NodeInterface.gasEstimateL1Component.
+type ArbitrumL1GasEstimator struct {
+	Backend ethereum.ContractCaller
+}
+
+func NewArbitrumL1GasEstimator(backend ethereum.ContractCaller) *ArbitrumL1GasEstimator {
+	return &ArbitrumL1GasEstimator{Backend: backend}
+}
+
+func (e *ArbitrumL1GasEstimator) L1Component(ctx context.Context, to common.Address, data []byte) (*ArbitrumL1Component, error) {
+	input, err := nodeInterface.Pack("gasEstimateL1Component", to, false, data)
+	if err != nil {
+		return nil, err
+	}
+	out, err := e.Backend.CallContract(ctx, ethereum.CallMsg{To: &NodeInterfaceAddress, Data: input}, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := nodeInterface.Unpack("gasEstimateL1Component", out)
+	if err != nil {
+		return nil, err
+	}
+	return &ArbitrumL1Component{
+		GasEstimateForL1:  res[0].(uint64),
+		BaseFee:           res[1].(*big.Int),
+		L1BaseFeeEstimate: res[2].(*big.Int),
+	}, nil
+}
+
+// UserOperationL1Component estimates the L1 component attributable to op's
+// share of a bundle sent to entryPoint.
+func (e *ArbitrumL1GasEstimator) UserOperationL1Component(ctx context.Context, op *UserOperation, entryPoint common.Address) (*ArbitrumL1Component, error) {
+	packed, err := op.Pack()
+	if err != nil {
+		return nil, err
+	}
+	return e.L1Component(ctx, entryPoint, packed)
+}
+
+// HandleOpsL1Component estimates the L1 component of a bundle of op alone,
+// i.e. of the full handleOps calldata, which is what Arbitrum bundlers
+// price preVerificationGas against. op's gas fields and signature are
+// replaced with placeholders so the result does not depend on the values
+// being estimated.
+func (e *ArbitrumL1GasEstimator) HandleOpsL1Component(ctx context.Context, op *UserOperation, entryPoint common.Address) (*ArbitrumL1Component, error) {
+	data, err := EncodeHandleOps([]*UserOperation{sanitizeForPVG(op)}, common.Address{})
+	if err != nil {
+		return nil, err
+	}
+	return e.L1Component(ctx, entryPoint, data)
+}
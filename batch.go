@@ -0,0 +1,96 @@
+package bundler_client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var ErrPrecedingOpFailed = errors.New("not sent: an earlier op of the sender on the same nonce key failed")
+
+// BatchError reports the items of a batch operation that failed. Errors is
+// aligned with the batch's input and nil for items that succeeded.
+type BatchError struct {
+	Errors []error
+}
+
+func (e *BatchError) Error() string {
+	var n int
+	var first error
+	for _, err := range e.Errors {
+		if err != nil {
+			if first == nil {
+				first = err
+			}
+			n++
+		}
+	}
+	return fmt.Sprintf("%d of %d failed, first: %v", n, len(e.Errors), first)
+}
+
+func (e *BatchError) Unwrap() []error {
+	var errs []error
+	for _, err := range e.Errors {
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// SendUserOperations sends ops to entryPoint, returning their hashes in the
+// order of ops. Ops of the same sender are sent one at a time in nonce
+// order, so the bundler never sees a nonce gap; different senders are sent
+// in parallel. Once an op fails, later ops of its sender on the same nonce
+// key are not sent and fail with ErrPrecedingOpFailed. If any op fails, the
+// hashes of the others are still returned along with a *BatchError.
+func (c *RpcClient) SendUserOperations(ctx context.Context, ops []*UserOperation, entryPoint common.Address) ([]common.Hash, error) {
+	bySender := make(map[common.Address][]int)
+	for i, op := range ops {
+		bySender[op.Sender] = append(bySender[op.Sender], i)
+	}
+	hashes := make([]common.Hash, len(ops))
+	errs := make([]error, len(ops))
+
+	var wg sync.WaitGroup
+	for _, idx := range bySender {
+		sort.SliceStable(idx, func(a, b int) bool { return opNonce(ops[idx[a]]).Cmp(opNonce(ops[idx[b]])) < 0 })
+		wg.Add(1)
+		go func(idx []int) {
+			defer wg.Done()
+			failed := make(map[string]bool)
+			for _, i := range idx {
+				key := NonceKey(opNonce(ops[i])).String()
+				if failed[key] {
+					errs[i] = ErrPrecedingOpFailed
+					continue
+				}
+				hashes[i], errs[i] = c.SendUserOperation(ctx, ops[i], entryPoint)
+				if errs[i] != nil {
+					failed[key] = true
+				}
+			}
+		}(idx)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return hashes, &BatchError{Errors: errs}
+		}
+	}
+	return hashes, nil
+}
+
+// opNonce returns op's nonce, treating an unset nonce as zero.
+func opNonce(op *UserOperation) *big.Int {
+	if op.Nonce == nil {
+		return new(big.Int)
+	}
+	return op.Nonce
+}
@@ -0,0 +1,86 @@
+package bundler_client
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// BiconomyClient exposes the biconomy_* extension methods of Biconomy
+// bundlers.
+type BiconomyClient struct {
+	c *RpcClient
+}
+
+// Biconomy returns the Biconomy extension client for c.
+func (c *RpcClient) Biconomy() *BiconomyClient {
+	return &BiconomyClient{c: c}
+}
+
+type biconomyGasFeeValues GasPriceTiers
+
+func (v *biconomyGasFeeValues) UnmarshalJSON(input []byte) error {
+	var tiers GasPriceTiers
+	if err := json.Unmarshal(input, &tiers); err != nil {
+		return err
+	}
+	if tiers.Standard.MaxFeePerGas == nil {
+		// Older deployments return a single untiered price.
+		var flat GasPrice
+		if err := json.Unmarshal(input, &flat); err != nil {
+			return err
+		}
+		tiers = GasPriceTiers{Slow: flat, Standard: flat, Fast: flat}
+	}
+	*v = biconomyGasFeeValues(tiers)
+	return nil
+}
+
+// GetGasFeeValues returns the fees suggested by biconomy_getGasFeeValues. If
+// the bundler returns a single price, all tiers are set to it.
+func (c *BiconomyClient) GetGasFeeValues(ctx context.Context) (*GasPriceTiers, error) {
+	var values biconomyGasFeeValues
+	err := c.c.call(ctx, &values, "biconomy_getGasFeeValues", []interface{}{}...)
+	if err != nil {
+		return nil, err
+	}
+	return (*GasPriceTiers)(&values), nil
+}
+
+// BiconomyStatus is the result of biconomy_getUserOperationStatus. State is
+// one of "BUNDLER_MEMPOOL", "SUBMITTED", "CONFIRMED", "FAILED" or
+// "DROPPED_FROM_BUNDLER_MEMPOOL".
+type BiconomyStatus struct {
+	State                string                `json:"state"`
+	TransactionHash      *common.Hash          `json:"transactionHash"`
+	UserOperationReceipt *UserOperationReceipt `json:"userOperationReceipt"`
+	Message              string                `json:"message"`
+}
+
+// OpStatus maps the Biconomy state onto OpStatus.
+func (s *BiconomyStatus) OpStatus() OpStatus {
+	switch s.State {
+	case "BUNDLER_MEMPOOL", "SUBMITTED":
+		return OpStatusPending
+	case "CONFIRMED":
+		if s.UserOperationReceipt != nil && !s.UserOperationReceipt.Success {
+			return OpStatusReverted
+		}
+		return OpStatusIncluded
+	case "FAILED":
+		return OpStatusReverted
+	case "DROPPED_FROM_BUNDLER_MEMPOOL":
+		return OpStatusDropped
+	}
+	return OpStatusUnknown
+}
+
+func (c *BiconomyClient) GetUserOperationStatus(ctx context.Context, userOpHash common.Hash) (*BiconomyStatus, error) {
+	var status BiconomyStatus
+	err := c.c.call(ctx, &status, "biconomy_getUserOperationStatus", userOpHash)
+	if err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
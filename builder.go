@@ -0,0 +1,198 @@
+package bundler_client
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var ErrMissingSender = errors.New("user operation has no sender")
+
+// UserOperationBuilder assembles ops field by field:
+//
+//	op, err := NewUserOperationBuilder().
+//		Sender(account).
+//		Nonce(nonce).
+//		CallData(data).
+//		WithPaymaster(paymaster, paymasterData).
+//		Build()
+//
+// Unset numeric fields default to zero and unset byte fields to empty, so
+// the result can be sent for estimation as is. Build produces a v0.6 op and
+// BuildPacked a v0.7 one, to be sent with SendPackedUserOperation; the
+// factory and paymaster are encoded accordingly.
+type UserOperationBuilder struct {
+	op            UserOperation
+	hasSender     bool
+	factory       *common.Address
+	factoryData   []byte
+	paymaster     *common.Address
+	paymasterData []byte
+	pmVGL         *big.Int
+	pmPostOpGas   *big.Int
+}
+
+func NewUserOperationBuilder() *UserOperationBuilder {
+	return &UserOperationBuilder{}
+}
+
+func (b *UserOperationBuilder) Sender(sender common.Address) *UserOperationBuilder {
+	b.op.Sender = sender
+	b.hasSender = true
+	return b
+}
+
+func (b *UserOperationBuilder) Nonce(nonce *big.Int) *UserOperationBuilder {
+	b.op.Nonce = nonce
+	return b
+}
+
+// Factory deploys the sender by calling factory with factoryData.
+func (b *UserOperationBuilder) Factory(factory common.Address, factoryData []byte) *UserOperationBuilder {
+	b.factory = &factory
+	b.factoryData = factoryData
+	return b
+}
+
+func (b *UserOperationBuilder) CallData(data []byte) *UserOperationBuilder {
+	b.op.CallData = data
+	return b
+}
+
+func (b *UserOperationBuilder) CallGasLimit(gas *big.Int) *UserOperationBuilder {
+	b.op.CallGasLimit = gas
+	return b
+}
+
+func (b *UserOperationBuilder) VerificationGasLimit(gas *big.Int) *UserOperationBuilder {
+	b.op.VerificationGasLimit = gas
+	return b
+}
+
+func (b *UserOperationBuilder) PreVerificationGas(gas *big.Int) *UserOperationBuilder {
+	b.op.PreVerificationGas = gas
+	return b
+}
+
+// WithGasEstimates sets the gas limits from a bundler estimate.
+func (b *UserOperationBuilder) WithGasEstimates(e *GasEstimates) *UserOperationBuilder {
+	b.op.PreVerificationGas = e.PreVerificationGas
+	b.op.VerificationGasLimit = e.VerificationGasLimit
+	if b.op.VerificationGasLimit == nil {
+		b.op.VerificationGasLimit = e.VerificationGas
+	}
+	b.op.CallGasLimit = e.CallGasLimit
+	if e.PaymasterVerificationGasLimit != nil || e.PaymasterPostOpGasLimit != nil {
+		b.pmVGL, b.pmPostOpGas = e.PaymasterVerificationGasLimit, e.PaymasterPostOpGasLimit
+	}
+	return b
+}
+
+func (b *UserOperationBuilder) GasFees(maxFeePerGas, maxPriorityFeePerGas *big.Int) *UserOperationBuilder {
+	b.op.MaxFeePerGas = maxFeePerGas
+	b.op.MaxPriorityFeePerGas = maxPriorityFeePerGas
+	return b
+}
+
+func (b *UserOperationBuilder) WithPaymaster(paymaster common.Address, paymasterData []byte) *UserOperationBuilder {
+	b.paymaster = &paymaster
+	b.paymasterData = paymasterData
+	return b
+}
+
+// WithPaymasterGasLimits sets the paymaster's gas limits, which are only
+// part of the v0.7 format.
+func (b *UserOperationBuilder) WithPaymasterGasLimits(verificationGasLimit, postOpGasLimit *big.Int) *UserOperationBuilder {
+	b.pmVGL, b.pmPostOpGas = verificationGasLimit, postOpGasLimit
+	return b
+}
+
+func (b *UserOperationBuilder) Signature(sig []byte) *UserOperationBuilder {
+	b.op.Signature = sig
+	return b
+}
+
+func (b *UserOperationBuilder) Eip7702Auth(auth *Eip7702Auth) *UserOperationBuilder {
+	b.op.Eip7702Auth = auth
+	return b
+}
+
+// Build returns the op in the v0.6 format.
+func (b *UserOperationBuilder) Build() (*UserOperation, error) {
+	if !b.hasSender {
+		return nil, ErrMissingSender
+	}
+	op := b.op
+	for _, v := range []**big.Int{&op.Nonce, &op.CallGasLimit, &op.VerificationGasLimit, &op.PreVerificationGas, &op.MaxFeePerGas, &op.MaxPriorityFeePerGas} {
+		if *v == nil {
+			*v = new(big.Int)
+		} else {
+			*v = new(big.Int).Set(*v)
+		}
+	}
+	op.InitCode = addressAndData(b.factory, b.factoryData)
+	op.PaymasterAndData = addressAndData(b.paymaster, b.paymasterData)
+	if op.CallData == nil {
+		op.CallData = []byte{}
+	}
+	if op.Signature == nil {
+		op.Signature = []byte{}
+	}
+	return &op, nil
+}
+
+// BuildPacked returns the op in the v0.7 format.
+func (b *UserOperationBuilder) BuildPacked() (*PackedUserOperation, error) {
+	op, err := b.Build()
+	if err != nil {
+		return nil, err
+	}
+	packed := &PackedUserOperation{
+		Sender:             op.Sender,
+		Nonce:              op.Nonce,
+		InitCode:           op.InitCode,
+		CallData:           op.CallData,
+		PreVerificationGas: op.PreVerificationGas,
+		PaymasterAndData:   []byte{},
+		Signature:          op.Signature,
+	}
+	if packed.AccountGasLimits, err = packUint128s(op.VerificationGasLimit, op.CallGasLimit); err != nil {
+		return nil, err
+	}
+	if packed.GasFees, err = packUint128s(op.MaxPriorityFeePerGas, op.MaxFeePerGas); err != nil {
+		return nil, err
+	}
+	if b.paymaster != nil {
+		limits, err := packUint128s(b.pmVGL, b.pmPostOpGas)
+		if err != nil {
+			return nil, err
+		}
+		packed.PaymasterAndData = append(append(b.paymaster.Bytes(), limits[:]...), b.paymasterData...)
+	}
+	return packed, nil
+}
+
+func addressAndData(addr *common.Address, data []byte) []byte {
+	if addr == nil {
+		return []byte{}
+	}
+	return append(addr.Bytes(), data...)
+}
+
+// packUint128s packs hi and lo, each at most 128 bits, into one word. nil
+// values are packed as zero.
+func packUint128s(hi, lo *big.Int) ([32]byte, error) {
+	var word [32]byte
+	for i, v := range []*big.Int{hi, lo} {
+		if v == nil {
+			continue
+		}
+		if v.Sign() < 0 || v.BitLen() > 128 {
+			return word, fmt.Errorf("value %s does not fit in 128 bits", v)
+		}
+		v.FillBytes(word[i*16 : (i+1)*16])
+	}
+	return word, nil
+}
@@ -0,0 +1,244 @@
+package bundler_client
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const handleOpsV06ABI = `[{"type":"function","name":"handleOps","inputs":[{"name":"ops","type":"tuple[]","components":[
+	{"name":"sender","type":"address"},{"name":"nonce","type":"uint256"},{"name":"initCode","type":"bytes"},{"name":"callData","type":"bytes"},
+	{"name":"callGasLimit","type":"uint256"},{"name":"verificationGasLimit","type":"uint256"},{"name":"preVerificationGas","type":"uint256"},
+	{"name":"maxFeePerGas","type":"uint256"},{"name":"maxPriorityFeePerGas","type":"uint256"},{"name":"paymasterAndData","type":"bytes"},{"name":"signature","type":"bytes"}]},
+	{"name":"beneficiary","type":"address"}],"outputs":[]}]`
+
+const handleOpsV07ABI = `[{"type":"function","name":"handleOps","inputs":[{"name":"ops","type":"tuple[]","components":[
+	{"name":"sender","type":"address"},{"name":"nonce","type":"uint256"},{"name":"initCode","type":"bytes"},{"name":"callData","type":"bytes"},
+	{"name":"accountGasLimits","type":"bytes32"},{"name":"preVerificationGas","type":"uint256"},{"name":"gasFees","type":"bytes32"},
+	{"name":"paymasterAndData","type":"bytes"},{"name":"signature","type":"bytes"}]},
+	{"name":"beneficiary","type":"address"}],"outputs":[]}]`
+
+var (
+	handleOpsV06, _ = abi.JSON(strings.NewReader(handleOpsV06ABI))
+	handleOpsV07, _ = abi.JSON(strings.NewReader(handleOpsV07ABI))
+
+	ErrNotHandleOps = errors.New("input is not a handleOps call")
+)
+
+// PackedUserOperation is the on-chain representation of a v0.7 user
+// operation, with gas limits and fees packed into 32 byte words. It is
+// encoded to JSON in the unpacked ERC-7769 form used by the bundler RPC API,
+// with the factory, paymaster and gas fields split out.
+type PackedUserOperation struct {
+	Sender             common.Address
+	Nonce              *big.Int
+	InitCode           []byte
+	CallData           []byte
+	AccountGasLimits   [32]byte
+	PreVerificationGas *big.Int
+	GasFees            [32]byte
+	PaymasterAndData   []byte
+	Signature          []byte
+}
+
+type packedUserOperationJSON struct {
+	Sender                        common.Address  `json:"sender"`
+	Nonce                         *hexutil.Big    `json:"nonce"`
+	Factory                       *common.Address `json:"factory,omitempty"`
+	FactoryData                   hexutil.Bytes   `json:"factoryData,omitempty"`
+	CallData                      hexutil.Bytes   `json:"callData"`
+	CallGasLimit                  *hexutil.Big    `json:"callGasLimit"`
+	VerificationGasLimit          *hexutil.Big    `json:"verificationGasLimit"`
+	PreVerificationGas            *hexutil.Big    `json:"preVerificationGas"`
+	MaxFeePerGas                  *hexutil.Big    `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas          *hexutil.Big    `json:"maxPriorityFeePerGas"`
+	Paymaster                     *common.Address `json:"paymaster,omitempty"`
+	PaymasterVerificationGasLimit *hexutil.Big    `json:"paymasterVerificationGasLimit,omitempty"`
+	PaymasterPostOpGasLimit       *hexutil.Big    `json:"paymasterPostOpGasLimit,omitempty"`
+	PaymasterData                 hexutil.Bytes   `json:"paymasterData,omitempty"`
+	Signature                     hexutil.Bytes   `json:"signature"`
+}
+
+func (op PackedUserOperation) MarshalJSON() ([]byte, error) {
+	enc := packedUserOperationJSON{
+		Sender:               op.Sender,
+		Nonce:                (*hexutil.Big)(op.Nonce),
+		CallData:             hexutil.Bytes(op.CallData),
+		CallGasLimit:         (*hexutil.Big)(op.CallGasLimit()),
+		VerificationGasLimit: (*hexutil.Big)(op.VerificationGasLimit()),
+		PreVerificationGas:   (*hexutil.Big)(op.PreVerificationGas),
+		MaxFeePerGas:         (*hexutil.Big)(op.MaxFeePerGas()),
+		MaxPriorityFeePerGas: (*hexutil.Big)(op.MaxPriorityFeePerGas()),
+		Signature:            hexutil.Bytes(op.Signature),
+	}
+	if enc.CallData == nil {
+		enc.CallData = hexutil.Bytes{}
+	}
+	if enc.Signature == nil {
+		enc.Signature = hexutil.Bytes{}
+	}
+	if len(op.InitCode) > 0 {
+		if len(op.InitCode) < common.AddressLength {
+			return nil, fmt.Errorf("initCode of %d bytes has no factory address", len(op.InitCode))
+		}
+		factory := common.BytesToAddress(op.InitCode[:common.AddressLength])
+		enc.Factory, enc.FactoryData = &factory, op.InitCode[common.AddressLength:]
+	}
+	if len(op.PaymasterAndData) > 0 {
+		if len(op.PaymasterAndData) < paymasterV07StaticLength {
+			return nil, ErrPaymasterDataTooShort
+		}
+		pm := op.PaymasterAndData
+		paymaster := common.BytesToAddress(pm[:common.AddressLength])
+		enc.Paymaster = &paymaster
+		enc.PaymasterVerificationGasLimit = (*hexutil.Big)(new(big.Int).SetBytes(pm[common.AddressLength : common.AddressLength+16]))
+		enc.PaymasterPostOpGasLimit = (*hexutil.Big)(new(big.Int).SetBytes(pm[common.AddressLength+16 : paymasterV07StaticLength]))
+		enc.PaymasterData = pm[paymasterV07StaticLength:]
+	}
+	return json.Marshal(&enc)
+}
+
+func (op *PackedUserOperation) UnmarshalJSON(input []byte) error {
+	var dec packedUserOperationJSON
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	accountGasLimits, err := packUint128s(dec.VerificationGasLimit.ToInt(), dec.CallGasLimit.ToInt())
+	if err != nil {
+		return err
+	}
+	gasFees, err := packUint128s(dec.MaxPriorityFeePerGas.ToInt(), dec.MaxFeePerGas.ToInt())
+	if err != nil {
+		return err
+	}
+	*op = PackedUserOperation{
+		Sender:             dec.Sender,
+		Nonce:              dec.Nonce.ToInt(),
+		CallData:           canonicalBytes(dec.CallData),
+		AccountGasLimits:   accountGasLimits,
+		PreVerificationGas: dec.PreVerificationGas.ToInt(),
+		GasFees:            gasFees,
+		Signature:          canonicalBytes(dec.Signature),
+	}
+	if dec.Factory != nil {
+		op.InitCode = append(dec.Factory.Bytes(), dec.FactoryData...)
+	}
+	if dec.Paymaster != nil {
+		limits, err := packUint128s(dec.PaymasterVerificationGasLimit.ToInt(), dec.PaymasterPostOpGasLimit.ToInt())
+		if err != nil {
+			return err
+		}
+		op.PaymasterAndData = append(append(dec.Paymaster.Bytes(), limits[:]...), dec.PaymasterData...)
+	}
+	return nil
+}
+
+// VerificationGasLimit returns the high 128 bits of AccountGasLimits.
+func (op *PackedUserOperation) VerificationGasLimit() *big.Int {
+	return new(big.Int).SetBytes(op.AccountGasLimits[:16])
+}
+
+// CallGasLimit returns the low 128 bits of AccountGasLimits.
+func (op *PackedUserOperation) CallGasLimit() *big.Int {
+	return new(big.Int).SetBytes(op.AccountGasLimits[16:])
+}
+
+// MaxPriorityFeePerGas returns the high 128 bits of GasFees.
+func (op *PackedUserOperation) MaxPriorityFeePerGas() *big.Int {
+	return new(big.Int).SetBytes(op.GasFees[:16])
+}
+
+// MaxFeePerGas returns the low 128 bits of GasFees.
+func (op *PackedUserOperation) MaxFeePerGas() *big.Int {
+	return new(big.Int).SetBytes(op.GasFees[16:])
+}
+
+// DecodedBundle is the content of a handleOps bundle transaction. Ops is
+// populated for v0.6 bundles and PackedOps for v0.7 bundles.
+type DecodedBundle struct {
+	Version     EntryPointVersion
+	Beneficiary common.Address
+	Ops         []*UserOperation
+	PackedOps   []*PackedUserOperation
+}
+
+// Len returns the number of ops in the bundle.
+func (b *DecodedBundle) Len() int {
+	if b.Version == EntryPointVersion07 {
+		return len(b.PackedOps)
+	}
+	return len(b.Ops)
+}
+
+// DecodeHandleOps extracts the user operations and beneficiary from the input
+// data of a v0.6 or v0.7 handleOps transaction.
+func DecodeHandleOps(input []byte) (*DecodedBundle, error) {
+	if len(input) < 4 {
+		return nil, ErrNotHandleOps
+	}
+	selector, data := input[:4], input[4:]
+	switch {
+	case bytes.Equal(selector, handleOpsV06.Methods["handleOps"].ID):
+		var args struct {
+			Ops         []UserOperation
+			Beneficiary common.Address
+		}
+		if err := unpackArgs(handleOpsV06, data, &args); err != nil {
+			return nil, err
+		}
+		b := &DecodedBundle{Version: EntryPointVersion06, Beneficiary: args.Beneficiary}
+		for i := range args.Ops {
+			b.Ops = append(b.Ops, &args.Ops[i])
+		}
+		return b, nil
+	case bytes.Equal(selector, handleOpsV07.Methods["handleOps"].ID):
+		var args struct {
+			Ops         []PackedUserOperation
+			Beneficiary common.Address
+		}
+		if err := unpackArgs(handleOpsV07, data, &args); err != nil {
+			return nil, err
+		}
+		b := &DecodedBundle{Version: EntryPointVersion07, Beneficiary: args.Beneficiary}
+		for i := range args.Ops {
+			b.PackedOps = append(b.PackedOps, &args.Ops[i])
+		}
+		return b, nil
+	}
+	return nil, ErrNotHandleOps
+}
+
+func unpackArgs(a abi.ABI, data []byte, v interface{}) error {
+	values, err := a.Methods["handleOps"].Inputs.Unpack(data)
+	if err != nil {
+		return err
+	}
+	return a.Methods["handleOps"].Inputs.Copy(v, values)
+}
+
+// EncodeHandleOps returns the input data of a v0.6 handleOps call bundling
+// ops, paying fees to beneficiary.
+func EncodeHandleOps(ops []*UserOperation, beneficiary common.Address) ([]byte, error) {
+	values := make([]UserOperation, len(ops))
+	for i, op := range ops {
+		values[i] = *op
+	}
+	return handleOpsV06.Pack("handleOps", values, beneficiary)
+}
+
+// EncodePackedHandleOps returns the input data of a v0.7 handleOps call
+// bundling ops, paying fees to beneficiary.
+func EncodePackedHandleOps(ops []*PackedUserOperation, beneficiary common.Address) ([]byte, error) {
+	values := make([]PackedUserOperation, len(ops))
+	for i, op := range ops {
+		values[i] = *op
+	}
+	return handleOpsV07.Pack("handleOps", values, beneficiary)
+}
@@ -0,0 +1,283 @@
+// Package bundlertest starts real bundlers in Docker containers, wired to an
+// anvil dev chain, for integration tests of code built on the bundler client:
+//
+//	c := bundlertest.Start(t, bundlertest.Alto, bundlertest.WithCode(bundler_client.EntryPointV06, code))
+//
+// Anvil starts empty, so the canonical EntryPoints the bundler is configured
+// for must be etched with WithCode, e.g. with the runtime code read from a
+// chain they are deployed on (for v0.6 together with its SenderCreator, whose
+// address is an immutable of the EntryPoint code). Start fails if they are
+// missing.
+//
+// It drives the docker CLI directly, so the only requirement is a working
+// docker installation. Tests are skipped if docker is unavailable. The
+// container logs are attached to the test output if it fails.
+package bundlertest
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	bundler_client "github.com/mdehoog/go-bundler-client"
+)
+
+// AnvilKey is anvil's first default dev account key, funded at genesis.
+const AnvilKey = "0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+
+// Spec describes how to run a bundler container. Args and Env receive the RPC
+// URL of the anvil container as reachable from inside the docker network; Env
+// entries are of the form KEY=VALUE.
+type Spec struct {
+	Name  string
+	Image string
+	Port  int
+	Args  func(nodeURL string) []string
+	Env   func(nodeURL string) []string
+	// EntryPoints are the EntryPoints the bundler is configured for, which
+	// must have code on the chain.
+	EntryPoints []common.Address
+}
+
+// Anvil is the dev chain every bundler is wired to.
+var Anvil = Spec{
+	Name:  "anvil",
+	Image: "ghcr.io/foundry-rs/foundry:latest",
+	Port:  8545,
+	Args:  func(string) []string { return []string{"anvil --host 0.0.0.0"} },
+}
+
+// AnvilAddress is the address of AnvilKey.
+var AnvilAddress = common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+
+// Alto runs Pimlico's Alto bundler against the canonical v0.6 and v0.7
+// EntryPoints.
+var Alto = Spec{
+	Name:        "alto",
+	Image:       "ghcr.io/pimlicolabs/alto:latest",
+	Port:        4337,
+	EntryPoints: []common.Address{bundler_client.EntryPointV06, bundler_client.EntryPointV07},
+	Args: func(nodeURL string) []string {
+		return []string{
+			"--rpc-url", nodeURL,
+			"--entrypoints", bundler_client.EntryPointV06.Hex() + "," + bundler_client.EntryPointV07.Hex(),
+			"--executor-private-keys", AnvilKey,
+			"--utility-private-key", AnvilKey,
+			"--safe-mode", "false",
+			"--port", "4337",
+		}
+	},
+}
+
+// Rundler runs Alchemy's Rundler in unsafe mode against the canonical v0.6
+// and v0.7 EntryPoints.
+var Rundler = Spec{
+	Name:        "rundler",
+	Image:       "alchemyplatform/rundler:latest",
+	Port:        3000,
+	EntryPoints: []common.Address{bundler_client.EntryPointV06, bundler_client.EntryPointV07},
+	Args: func(nodeURL string) []string {
+		return []string{
+			"node",
+			"--node_http", nodeURL,
+			"--builder.private_key", AnvilKey,
+			"--rpc.port", "3000",
+			"--unsafe",
+		}
+	},
+}
+
+// Skandha runs Etherspot's Skandha in unsafe mode against the canonical v0.6
+// EntryPoint, configured through its environment variables.
+var Skandha = Spec{
+	Name:        "skandha",
+	Image:       "etherspot/skandha:latest",
+	Port:        14337,
+	EntryPoints: []common.Address{bundler_client.EntryPointV06},
+	Args: func(string) []string {
+		return []string{"standalone", "--unsafeMode", "--api.address", "0.0.0.0", "--api.port", "14337"}
+	},
+	Env: func(nodeURL string) []string {
+		return []string{
+			"SKANDHA_RPC=" + nodeURL,
+			"SKANDHA_ENTRYPOINTS=" + bundler_client.EntryPointV06.Hex(),
+			"SKANDHA_RELAYERS=" + AnvilKey,
+			"SKANDHA_BENEFICIARY=" + AnvilAddress.Hex(),
+		}
+	},
+}
+
+type config struct {
+	code map[common.Address][]byte
+}
+
+type Option func(*config)
+
+// WithCode sets the runtime code at address on the dev chain with
+// anvil_setCode before the bundler starts.
+func WithCode(address common.Address, code []byte) Option {
+	return func(c *config) {
+		c.code[address] = code
+	}
+}
+
+// Env is a running bundler and dev chain.
+type Env struct {
+	Client     bundler_client.Client
+	BundlerURL string
+	NodeURL    string
+}
+
+// Start runs spec alongside an anvil container and returns a dialed client.
+// The containers and network are removed when the test finishes.
+func Start(t testing.TB, spec Spec, opts ...Option) *Env {
+	t.Helper()
+	cfg := config{code: make(map[common.Address][]byte)}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if _, err := exec.LookPath("docker"); err != nil {
+		t.Skip("docker not available")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	network := "bundlertest-" + randomSuffix()
+	if _, err := docker(ctx, "network", "create", network); err != nil {
+		t.Fatalf("creating docker network: %v", err)
+	}
+	t.Cleanup(func() { _, _ = docker(context.Background(), "network", "rm", network) })
+
+	nodeURL := run(ctx, t, network, Anvil, "", true)
+	prepareNode(ctx, t, nodeURL, cfg.code, spec.EntryPoints)
+	bundlerURL := run(ctx, t, network, spec, fmt.Sprintf("http://%s:%d", Anvil.Name, Anvil.Port), false)
+
+	c := waitReady(ctx, t, bundlerURL)
+	return &Env{Client: c, BundlerURL: bundlerURL, NodeURL: nodeURL}
+}
+
+// run starts a container for spec and returns its host-reachable URL.
+func run(ctx context.Context, t testing.TB, network string, spec Spec, nodeURL string, shell bool) string {
+	t.Helper()
+	args := []string{"run", "-d", "--network", network, "--network-alias", spec.Name, "-p", fmt.Sprintf("127.0.0.1::%d", spec.Port)}
+	if spec.Env != nil {
+		for _, e := range spec.Env(nodeURL) {
+			args = append(args, "-e", e)
+		}
+	}
+	if shell {
+		args = append(args, "--entrypoint", "sh", spec.Image, "-c")
+	} else {
+		args = append(args, spec.Image)
+	}
+	args = append(args, spec.Args(nodeURL)...)
+	id, err := docker(ctx, args...)
+	if err != nil {
+		t.Fatalf("starting %s: %v", spec.Name, err)
+	}
+	t.Cleanup(func() {
+		if t.Failed() {
+			logs, err := dockerCombined(context.Background(), "logs", id)
+			if err != nil {
+				t.Logf("reading %s logs: %v", spec.Name, err)
+			}
+			t.Logf("%s logs:\n%s", spec.Name, logs)
+		}
+		_, _ = docker(context.Background(), "rm", "-f", id)
+	})
+
+	hostPort, err := docker(ctx, "port", id, fmt.Sprintf("%d/tcp", spec.Port))
+	if err != nil {
+		t.Fatalf("resolving %s port: %v", spec.Name, err)
+	}
+	return "http://" + strings.Split(hostPort, "\n")[0]
+}
+
+// prepareNode waits for anvil to serve requests, sets code on it and checks
+// that entryPoints are deployed.
+func prepareNode(ctx context.Context, t testing.TB, url string, code map[common.Address][]byte, entryPoints []common.Address) {
+	t.Helper()
+	var (
+		node    *rpc.Client
+		lastErr error
+	)
+	for ctx.Err() == nil {
+		var chainId hexutil.Big
+		if node, lastErr = rpc.DialContext(ctx, url); lastErr == nil {
+			if lastErr = node.CallContext(ctx, &chainId, "eth_chainId"); lastErr == nil {
+				break
+			}
+			node.Close()
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+	if ctx.Err() != nil {
+		t.Fatalf("anvil at %s not ready: %v", url, lastErr)
+	}
+	defer node.Close()
+
+	for address, c := range code {
+		if err := node.CallContext(ctx, nil, "anvil_setCode", address, hexutil.Bytes(c)); err != nil {
+			t.Fatalf("setting code at %s: %v", address, err)
+		}
+	}
+	for _, ep := range entryPoints {
+		var c hexutil.Bytes
+		if err := node.CallContext(ctx, &c, "eth_getCode", ep, "latest"); err != nil {
+			t.Fatalf("reading code at %s: %v", ep, err)
+		}
+		if len(c) == 0 {
+			t.Fatalf("no EntryPoint code at %s; set it with WithCode", ep)
+		}
+	}
+}
+
+func waitReady(ctx context.Context, t testing.TB, url string) bundler_client.Client {
+	t.Helper()
+	var lastErr error
+	for ctx.Err() == nil {
+		c, err := bundler_client.DialContext(ctx, url)
+		if err == nil {
+			if _, err = c.ChainId(ctx); err == nil {
+				return c
+			}
+		}
+		lastErr = err
+		time.Sleep(500 * time.Millisecond)
+	}
+	t.Fatalf("bundler at %s not ready: %v", url, lastErr)
+	return nil
+}
+
+func docker(ctx context.Context, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "docker", args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("docker %s: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// dockerCombined runs docker and returns its interleaved stdout and stderr,
+// where containers' logs end up.
+func dockerCombined(ctx context.Context, args ...string) (string, error) {
+	out, err := exec.CommandContext(ctx, "docker", args...).CombinedOutput()
+	return string(out), err
+}
+
+func randomSuffix() string {
+	b := make([]byte, 4)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
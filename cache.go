@@ -0,0 +1,63 @@
+package bundler_client
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// WithCaching caches the results of ChainId and SupportedEntryPoints, which
+// do not change for the lifetime of a bundler, so that they cost a single
+// round-trip per connection. Use Invalidate to refetch them, e.g. after the
+// bundler was reconfigured.
+func WithCaching() DialOption {
+	return func(c *dialConfig) { c.caching = true }
+}
+
+type staticCache struct {
+	mu          sync.Mutex
+	chainId     *big.Int
+	entryPoints []common.Address
+}
+
+func (s *staticCache) getChainId() *big.Int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.chainId == nil {
+		return nil
+	}
+	return new(big.Int).Set(s.chainId)
+}
+
+func (s *staticCache) setChainId(id *big.Int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.chainId = new(big.Int).Set(id)
+}
+
+func (s *staticCache) getEntryPoints() []common.Address {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.entryPoints == nil {
+		return nil
+	}
+	return append([]common.Address(nil), s.entryPoints...)
+}
+
+func (s *staticCache) setEntryPoints(eps []common.Address) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entryPoints = append(make([]common.Address, 0, len(eps)), eps...)
+}
+
+// Invalidate drops the results cached by WithCaching.
+func (c *RpcClient) Invalidate() {
+	if c.cache == nil {
+		return
+	}
+	c.cache.mu.Lock()
+	defer c.cache.mu.Unlock()
+	c.cache.chainId = nil
+	c.cache.entryPoints = nil
+}
@@ -0,0 +1,206 @@
+package bundler_client
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// ChainAdapter encapsulates chain-specific behavior so that it does not leak
+// into application code.
+type ChainAdapter interface {
+	Name() string
+	// AdjustPreVerificationGas adds any chain-specific component (such as an
+	// L1 data fee) to the locally computed pvg.
+	AdjustPreVerificationGas(ctx context.Context, op *UserOperation, entryPoint common.Address, pvg *big.Int) (*big.Int, error)
+	// AdjustGasFees applies chain fee quirks (such as minimum priority fees).
+	AdjustGasFees(maxFeePerGas, maxPriorityFeePerGas *big.Int) (*big.Int, *big.Int)
+	EntryPoints() []common.Address
+	SizeLimits() SizeLimits
+}
+
+// ChainAdapterFactory builds an adapter for a chain, given a backend for the
+// eth_calls some adapters need.
+type ChainAdapterFactory func(backend ethereum.ContractCaller) ChainAdapter
+
+var (
+	chainAdaptersMu sync.RWMutex
+	chainAdapters   = map[uint64]ChainAdapterFactory{}
+)
+
+// RegisterChainAdapter registers the adapter factory for a chain, replacing
+// any built-in one.
+func RegisterChainAdapter(chainId uint64, f ChainAdapterFactory) {
+	chainAdaptersMu.Lock()
+	defer chainAdaptersMu.Unlock()
+	chainAdapters[chainId] = f
+}
+
+// ChainAdapterFor returns the adapter for the given chain, falling back to
+// the mainnet adapter for unregistered chains.
+func ChainAdapterFor(chainId uint64, backend ethereum.ContractCaller) ChainAdapter {
+	chainAdaptersMu.RLock()
+	f, ok := chainAdapters[chainId]
+	chainAdaptersMu.RUnlock()
+	if !ok {
+		return &MainnetAdapter{ChainId: chainId}
+	}
+	return f(backend)
+}
+
+func init() {
+	for _, id := range []uint64{1, 11155111} {
+		id := id
+		RegisterChainAdapter(id, func(ethereum.ContractCaller) ChainAdapter { return &MainnetAdapter{ChainId: id} })
+	}
+	for _, id := range []uint64{10, 8453, 7777777, 11155420, 84532} {
+		id := id
+		RegisterChainAdapter(id, func(b ethereum.ContractCaller) ChainAdapter {
+			headers, _ := b.(HeaderReader)
+			return &OPStackAdapter{MainnetAdapter: MainnetAdapter{ChainId: id}, L1: NewOPStackL1FeeEstimator(b), Headers: headers}
+		})
+	}
+	for _, id := range []uint64{42161, 42170, 421614} {
+		id := id
+		RegisterChainAdapter(id, func(b ethereum.ContractCaller) ChainAdapter {
+			return &ArbitrumAdapter{MainnetAdapter: MainnetAdapter{ChainId: id}, L1: NewArbitrumL1GasEstimator(b), BufferPercent: DefaultArbitrumL1GasBufferPercent}
+		})
+	}
+	for _, id := range []uint64{137, 80002} {
+		id := id
+		RegisterChainAdapter(id, func(ethereum.ContractCaller) ChainAdapter {
+			return &PolygonAdapter{MainnetAdapter: MainnetAdapter{ChainId: id}, MinPriorityFee: big.NewInt(30 * params.GWei)}
+		})
+	}
+}
+
+// MainnetAdapter implements L1 semantics and is the base for other adapters.
+type MainnetAdapter struct {
+	ChainId uint64
+}
+
+func (a *MainnetAdapter) Name() string { return "mainnet" }
+
+func (a *MainnetAdapter) AdjustPreVerificationGas(_ context.Context, _ *UserOperation, _ common.Address, pvg *big.Int) (*big.Int, error) {
+	return pvg, nil
+}
+
+func (a *MainnetAdapter) AdjustGasFees(maxFeePerGas, maxPriorityFeePerGas *big.Int) (*big.Int, *big.Int) {
+	return maxFeePerGas, maxPriorityFeePerGas
+}
+
+func (a *MainnetAdapter) EntryPoints() []common.Address {
+	return []common.Address{EntryPointV06, EntryPointV07, EntryPointV08}
+}
+
+func (a *MainnetAdapter) SizeLimits() SizeLimits {
+	return SizeLimitsForChain(a.ChainId)
+}
+
+// HeaderReader reads block headers, as *ethclient.Client and RpcClient do.
+type HeaderReader interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+var (
+	ErrNoMaxFeePerGas = errors.New("op has no maxFeePerGas to price the L1 fee at")
+	ErrNoHeaderReader = errors.New("adapter has no header reader for the base fee")
+)
+
+// OPStackAdapter adds the L1 data fee to preVerificationGas, converted to gas
+// at the price the op will pay: min(maxFeePerGas, baseFee +
+// maxPriorityFeePerGas), with the base fee of the latest block read from
+// Headers. Converting at maxFeePerGas alone would undercharge whenever the
+// op's fee cap is above the effective price.
+type OPStackAdapter struct {
+	MainnetAdapter
+	L1      *OPStackL1FeeEstimator
+	Headers HeaderReader
+}
+
+func (a *OPStackAdapter) Name() string { return "op-stack" }
+
+func (a *OPStackAdapter) AdjustPreVerificationGas(ctx context.Context, op *UserOperation, _ common.Address, pvg *big.Int) (*big.Int, error) {
+	if op.MaxFeePerGas == nil || op.MaxFeePerGas.Sign() == 0 {
+		return nil, ErrNoMaxFeePerGas
+	}
+	if a.Headers == nil {
+		return nil, ErrNoHeaderReader
+	}
+	head, err := a.Headers.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	price := op.MaxFeePerGas
+	if head.BaseFee != nil {
+		effective := new(big.Int).Set(head.BaseFee)
+		if op.MaxPriorityFeePerGas != nil {
+			effective.Add(effective, op.MaxPriorityFeePerGas)
+		}
+		if effective.Sign() > 0 && effective.Cmp(price) < 0 {
+			price = effective
+		}
+	}
+	fee, err := a.L1.UserOperationL1Fee(ctx, op)
+	if err != nil {
+		return nil, err
+	}
+	l1Gas := new(big.Int).Div(fee, price)
+	return l1Gas.Add(l1Gas, pvg), nil
+}
+
+// ArbitrumAdapter adds the NodeInterface L1 gas component of a single-op
+// bundle to preVerificationGas. The component is denominated in L2 gas at the
+// current L1 price, so it is increased by BufferPercent to absorb L1 price
+// movement before inclusion.
+type ArbitrumAdapter struct {
+	MainnetAdapter
+	L1            *ArbitrumL1GasEstimator
+	BufferPercent uint64
+}
+
+// DefaultArbitrumL1GasBufferPercent is the BufferPercent of the registered
+// Arbitrum adapters.
+const DefaultArbitrumL1GasBufferPercent = 10
+
+func (a *ArbitrumAdapter) Name() string { return "arbitrum" }
+
+func (a *ArbitrumAdapter) AdjustPreVerificationGas(ctx context.Context, op *UserOperation, entryPoint common.Address, pvg *big.Int) (*big.Int, error) {
+	c, err := a.L1.HandleOpsL1Component(ctx, op, entryPoint)
+	if err != nil {
+		return nil, err
+	}
+	l1Gas := new(big.Int).SetUint64(c.GasEstimateForL1)
+	l1Gas.Mul(l1Gas, new(big.Int).SetUint64(100+a.BufferPercent))
+	l1Gas.Div(l1Gas, big.NewInt(100))
+	return l1Gas.Add(l1Gas, pvg), nil
+}
+
+// PolygonAdapter enforces Polygon PoS's minimum priority fee.
+type PolygonAdapter struct {
+	MainnetAdapter
+	MinPriorityFee *big.Int
+}
+
+func (a *PolygonAdapter) Name() string { return "polygon" }
+
+func (a *PolygonAdapter) AdjustGasFees(maxFeePerGas, maxPriorityFeePerGas *big.Int) (*big.Int, *big.Int) {
+	if maxPriorityFeePerGas == nil || maxPriorityFeePerGas.Cmp(a.MinPriorityFee) < 0 {
+		diff := new(big.Int).Set(a.MinPriorityFee)
+		if maxPriorityFeePerGas != nil {
+			diff.Sub(diff, maxPriorityFeePerGas)
+		}
+		maxPriorityFeePerGas = new(big.Int).Set(a.MinPriorityFee)
+		if maxFeePerGas == nil {
+			maxFeePerGas = new(big.Int)
+		}
+		maxFeePerGas = new(big.Int).Add(maxFeePerGas, diff)
+	}
+	return maxFeePerGas, maxPriorityFeePerGas
+}
@@ -0,0 +1,149 @@
+package bundler_client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var ErrCircuitOpen = errors.New("circuit breaker open: bundler unavailable")
+
+type CircuitState int
+
+const (
+	CircuitClosed CircuitState = iota
+	CircuitOpen
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+// CircuitBreaker stops sending requests to a failing bundler. It opens after
+// MaxFailures consecutive failures, where a failure is a transport error, an
+// HTTP 5xx response or, if LatencyThreshold is set, a call slower than it.
+// JSON-RPC errors show the bundler is responsive and do not count. While
+// open, calls go to Fallback, or fail with ErrCircuitOpen if it is nil. After
+// Cooldown a single probe request is let through (half-open), whose outcome
+// closes or reopens the circuit.
+type CircuitBreaker struct {
+	MaxFailures      int
+	LatencyThreshold time.Duration
+	Cooldown         time.Duration
+	Fallback         *RpcClient
+	// OnStateChange is called on every transition, e.g. for alerting. It
+	// must not block.
+	OnStateChange func(from, to CircuitState)
+
+	mu       sync.Mutex
+	state    CircuitState
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+func NewCircuitBreaker(maxFailures int, cooldown time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{MaxFailures: maxFailures, Cooldown: cooldown}
+}
+
+// WithCircuitBreaker routes every request through b.
+func WithCircuitBreaker(b *CircuitBreaker) DialOption {
+	return func(c *dialConfig) { c.breaker = b }
+}
+
+func (b *CircuitBreaker) State() CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+func (b *CircuitBreaker) setState(s CircuitState) {
+	if b.state == s {
+		return
+	}
+	from := b.state
+	b.state = s
+	if b.OnStateChange != nil {
+		b.OnStateChange(from, s)
+	}
+}
+
+// allow reports whether a request may be sent to the bundler.
+func (b *CircuitBreaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case CircuitOpen:
+		if time.Since(b.openedAt) < b.Cooldown {
+			return false
+		}
+		b.setState(CircuitHalfOpen)
+		b.probing = true
+		return true
+	case CircuitHalfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+	}
+	return true
+}
+
+// release gives back a request allowed by allow that was never sent, so that
+// a half-open breaker lets the next request probe instead.
+func (b *CircuitBreaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
+
+func (b *CircuitBreaker) record(d time.Duration, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+	if errors.Is(err, context.Canceled) {
+		// The caller gave up; this says nothing about the bundler.
+		return
+	}
+	failed := isUnavailable(err) || (b.LatencyThreshold > 0 && d > b.LatencyThreshold)
+	if !failed {
+		b.failures = 0
+		b.setState(CircuitClosed)
+		return
+	}
+	b.failures++
+	if b.state == CircuitHalfOpen || b.failures >= b.MaxFailures {
+		b.openedAt = time.Now()
+		b.setState(CircuitOpen)
+	}
+}
+
+// isUnavailable reports whether err indicates that the bundler could not
+// serve the request, as opposed to rejecting it.
+func isUnavailable(err error) bool {
+	if err == nil {
+		return false
+	}
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		return false
+	}
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode >= http.StatusInternalServerError
+	}
+	return true
+}
@@ -0,0 +1,65 @@
+package bundler_client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+func TestCircuitBreakerProbeAfterCancelledLimiterWait(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		var req struct {
+			ID json.RawMessage `json:"id"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": "0x1"})
+	}))
+	defer srv.Close()
+
+	limiter := rate.NewLimiter(rate.Every(time.Hour), 1)
+	limiter.Allow()
+	breaker := NewCircuitBreaker(1, time.Millisecond)
+	client, err := DialContext(context.Background(), srv.URL,
+		WithCircuitBreaker(breaker),
+		WithRateLimit(RateLimits{Methods: map[string]*rate.Limiter{"eth_chainId": limiter}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := client.(*RpcClient)
+	defer c.c.Close()
+
+	breaker.mu.Lock()
+	breaker.state, breaker.openedAt = CircuitOpen, time.Now().Add(-time.Second)
+	breaker.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := c.ChainId(ctx); err == nil {
+		t.Fatal("ChainId succeeded despite an exhausted limiter")
+	}
+	if state := breaker.State(); state != CircuitHalfOpen {
+		t.Fatalf("breaker state after cancelled wait: got %s, want %s", state, CircuitHalfOpen)
+	}
+	if n := requests.Load(); n != 0 {
+		t.Fatalf("cancelled call reached the bundler: %d requests", n)
+	}
+
+	if _, err := c.BlockNumber(context.Background()); err != nil {
+		t.Fatalf("probe after cancelled wait: %v", err)
+	}
+	if n := requests.Load(); n != 1 {
+		t.Fatalf("probe requests: got %d, want 1", n)
+	}
+	if state := breaker.State(); state != CircuitClosed {
+		t.Fatalf("breaker state after probe: got %s, want %s", state, CircuitClosed)
+	}
+}
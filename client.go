@@ -2,139 +2,380 @@ package bundler_client
 
 import (
 	"context"
+	"fmt"
 	"math/big"
+	"net/http"
+	"net/url"
+	"runtime/pprof"
+	"sync/atomic"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/rpc"
-	"github.com/stackup-wallet/stackup-bundler/pkg/entrypoint/filter"
-	"github.com/stackup-wallet/stackup-bundler/pkg/gas"
-	"github.com/stackup-wallet/stackup-bundler/pkg/userop"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type EthClient interface {
-	SendUserOperation(ctx context.Context, op *userop.UserOperation, entryPoint common.Address) (common.Hash, error)
-	EstimateUserOperationGas(ctx context.Context, op *userop.UserOperation, entryPoint common.Address) (*gas.GasEstimates, error)
+	SendUserOperation(ctx context.Context, op *UserOperation, entryPoint common.Address) (common.Hash, error)
+	EstimateUserOperationGas(ctx context.Context, op *UserOperation, entryPoint common.Address) (*GasEstimates, error)
 	// EstimateUserOperationGasWithOverrides is a non-spec method supported by some bundlers (e.g. Stackup)
-	EstimateUserOperationGasWithOverrides(ctx context.Context, op *userop.UserOperation, entryPoint common.Address, stateOverrides map[common.Address]OverrideAccount) (*gas.GasEstimates, error)
-	GetUserOperationReceipt(ctx context.Context, userOpHash common.Hash) (*filter.UserOperationReceipt, error)
-	GetUserOperationByHash(ctx context.Context, userOpHash common.Hash) (*filter.HashLookupResult, error)
+	EstimateUserOperationGasWithOverrides(ctx context.Context, op *UserOperation, entryPoint common.Address, stateOverrides map[common.Address]OverrideAccount) (*GasEstimates, error)
+	// GetUserOperationReceipt and GetUserOperationByHash return nil without
+	// an error when the bundler does not know the op (yet).
+	GetUserOperationReceipt(ctx context.Context, userOpHash common.Hash) (*UserOperationReceipt, error)
+	GetUserOperationByHash(ctx context.Context, userOpHash common.Hash) (*HashLookupResult, error)
+	// GetUserOperationStatus is a non-spec method supported by some bundlers (e.g. Rundler, Skandha)
+	GetUserOperationStatus(ctx context.Context, userOpHash common.Hash) (*UserOperationStatus, error)
 	SupportedEntryPoints(ctx context.Context) ([]common.Address, error)
 	ChainId(ctx context.Context) (*big.Int, error)
 }
 
 type DebugClient interface {
 	BundlerClearState(ctx context.Context) error
-	BundlerDumpMempool(ctx context.Context, entryPoint common.Address) ([]*userop.UserOperation, error)
+	BundlerDumpMempool(ctx context.Context, entryPoint common.Address) ([]*UserOperation, error)
 	BundlerSendBundleNow(ctx context.Context) (*common.Hash, error)
-	BundlerSetBundlingMode(ctx context.Context, mode string) error
+	BundlerSetBundlingMode(ctx context.Context, mode BundlingMode) error
+	// BundlerGetBundlingMode is a non-spec method supported by some bundlers
+	BundlerGetBundlingMode(ctx context.Context) (BundlingMode, error)
+	BundlerDumpReputation(ctx context.Context, entryPoint common.Address) ([]ReputationEntry, error)
+	BundlerSetReputation(ctx context.Context, entries []ReputationEntry, entryPoint common.Address) error
+	// BundlerAddUserOps injects ops into the mempool without validating them.
+	BundlerAddUserOps(ctx context.Context, ops []*UserOperation, entryPoint common.Address) error
 }
 
+// BundlingMode controls when a bundler submits bundles: on every accepted op
+// (Auto), or only when asked to via debug_bundler_sendBundleNow (Manual).
+type BundlingMode string
+
+const (
+	BundlingModeAuto   BundlingMode = "auto"
+	BundlingModeManual BundlingMode = "manual"
+)
+
+func (m BundlingMode) Valid() bool {
+	return m == BundlingModeAuto || m == BundlingModeManual
+}
+
+// Client is the interface implemented by RpcClient and by the wrappers
+// around it, such as NewVerifyingClient. Wrappers only expose Client: keep
+// the *RpcClient to reach its other methods, such as
+// SendPackedUserOperation or Vars.
 type Client interface {
 	EthClient
 	DebugClient
 }
 
 type RpcClient struct {
-	c *rpc.Client
+	c        *rpc.Client
+	endpoint string
+	stats    *stats
+	compat   atomic.Pointer[CompatProfile]
+	retry    *RetryPolicy
+	tracer   trace.Tracer
+	hooks    []Hook
+	spec     SpecVersion
+	cache    *staticCache
+	timeout  time.Duration
+	limits   *RateLimits
+	breaker  *CircuitBreaker
+	node     *RpcClient
+}
+
+// DialOption configures the connection made by Dial and DialContext.
+type DialOption func(*dialConfig)
+
+type dialConfig struct {
+	rpcOpts    []rpc.ClientOption
+	httpClient *http.Client
+	retry      *RetryPolicy
+	tracing    *tracingConfig
+	hooks      []Hook
+	spec       SpecVersion
+	caching    bool
+	timeout    time.Duration
+	limits     *RateLimits
+	breaker    *CircuitBreaker
+}
+
+// WithHeader sets an HTTP header sent with every request, such as an
+// Authorization or x-api-key header required by hosted bundlers.
+func WithHeader(key, value string) DialOption {
+	return func(c *dialConfig) { c.rpcOpts = append(c.rpcOpts, rpc.WithHeader(key, value)) }
+}
+
+func WithHeaders(headers http.Header) DialOption {
+	return func(c *dialConfig) { c.rpcOpts = append(c.rpcOpts, rpc.WithHeaders(headers)) }
+}
+
+// WithHTTPClient sets the HTTP client used for HTTP endpoints.
+func WithHTTPClient(hc *http.Client) DialOption {
+	return func(c *dialConfig) { c.httpClient = hc }
 }
 
-func Dial(rawurl string) (Client, error) {
-	return DialContext(context.Background(), rawurl)
+// WithDefaultTimeout bounds each request whose context has no deadline of
+// its own by timeout, so calls cannot hang on an unresponsive bundler. With
+// WithRetry, the timeout applies to each attempt.
+func WithDefaultTimeout(timeout time.Duration) DialOption {
+	return func(c *dialConfig) { c.timeout = timeout }
 }
 
-func DialContext(ctx context.Context, rawurl string) (Client, error) {
-	c, err := rpc.DialContext(ctx, rawurl)
+func Dial(rawurl string, opts ...DialOption) (Client, error) {
+	return DialContext(context.Background(), rawurl, opts...)
+}
+
+func DialContext(ctx context.Context, rawurl string, opts ...DialOption) (Client, error) {
+	var cfg dialConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	rc := &RpcClient{endpoint: EndpointLabel(rawurl), stats: newStats(), retry: cfg.retry, hooks: cfg.hooks, spec: cfg.spec, timeout: cfg.timeout, limits: cfg.limits, breaker: cfg.breaker}
+	rc.stats.setBreaker(cfg.breaker)
+	if cfg.caching {
+		rc.cache = &staticCache{}
+	}
+	if cfg.tracing != nil {
+		rc.tracer = cfg.tracing.tracer()
+		cfg.httpClient = cfg.tracing.wrap(cfg.httpClient)
+	}
+	if cfg.httpClient != nil {
+		cfg.rpcOpts = append(cfg.rpcOpts, rpc.WithHTTPClient(cfg.httpClient))
+	}
+	c, err := rpc.DialOptions(ctx, rawurl, cfg.rpcOpts...)
 	if err != nil {
 		return nil, err
 	}
-	return NewClient(c), nil
+	rc.c = c
+	return rc, nil
+}
+
+// EndpointLabel strips credentials, paths and query parameters from rawurl,
+// as hosted bundlers commonly embed API keys in them. It returns the empty
+// string if rawurl cannot be parsed.
+func EndpointLabel(rawurl string) string {
+	u, err := url.Parse(rawurl)
+	if err != nil || u.Host == "" {
+		return ""
+	}
+	return u.Scheme + "://" + u.Host
 }
 
 func NewClient(c *rpc.Client) Client {
-	return &RpcClient{c}
+	return &RpcClient{c: c, stats: newStats()}
+}
+
+// call issues the RPC request, tagging the calling goroutine with pprof labels
+// so that profiles attribute cost to individual bundler methods, and retries
+// transient failures according to the retry policy.
+func (c *RpcClient) call(ctx context.Context, result interface{}, method string, args ...interface{}) (err error) {
+	if c.node != nil && nodeMethods[method] {
+		return c.node.call(ctx, result, method, args...)
+	}
+	if c.tracer != nil {
+		var span trace.Span
+		ctx, span = c.startSpan(ctx, method, args)
+		defer func() { endSpan(span, method, result, err) }()
+	}
+	for attempt := 0; ; attempt++ {
+		if c.breaker != nil && !c.breaker.allow() {
+			if c.breaker.Fallback != nil {
+				return c.breaker.Fallback.call(ctx, result, method, args...)
+			}
+			return ErrCircuitOpen
+		}
+		if c.limits != nil {
+			if err := c.limits.wait(ctx, method); err != nil {
+				if c.breaker != nil {
+					c.breaker.release()
+				}
+				return err
+			}
+		}
+		info := &CallInfo{Method: method, Params: args, Attempt: attempt}
+		for _, h := range c.hooks {
+			h.BeforeCall(ctx, info)
+		}
+		start := time.Now()
+		pprof.Do(ctx, pprof.Labels("method", method, "endpoint", c.endpoint), func(ctx context.Context) {
+			if _, ok := ctx.Deadline(); !ok && c.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, c.timeout)
+				defer cancel()
+			}
+			err = c.c.CallContext(ctx, result, method, args...)
+		})
+		info.Duration, info.Err = time.Since(start), err
+		if err == nil {
+			info.Result = result
+		}
+		c.stats.record(method, info.Duration, err)
+		if c.breaker != nil {
+			c.breaker.record(info.Duration, err)
+		}
+		for _, h := range c.hooks {
+			h.AfterCall(ctx, info)
+		}
+		if err == nil || c.retry == nil || ctx.Err() != nil || !c.retry.shouldRetry(method, attempt, err) {
+			return err
+		}
+		trace.SpanFromContext(ctx).AddEvent("retry", trace.WithAttributes(attribute.Int("attempt", attempt+1)))
+		select {
+		case <-time.After(c.retry.backoff(attempt)):
+		case <-ctx.Done():
+			return err
+		}
+	}
+}
+
+// Call issues an arbitrary RPC request through the client's retry, rate
+// limiting, hooks, tracing and metrics, for vendor methods the client has no
+// wrapper for. result must be a pointer, or nil to discard the result.
+func (c *RpcClient) Call(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	return c.call(ctx, result, method, args...)
 }
 
-func (c *RpcClient) SendUserOperation(ctx context.Context, op *userop.UserOperation, entryPoint common.Address) (common.Hash, error) {
+// CallAs is Call returning the decoded result.
+func CallAs[T any](ctx context.Context, c *RpcClient, method string, args ...interface{}) (T, error) {
+	var result T
+	err := c.call(ctx, &result, method, args...)
+	return result, err
+}
+
+func (c *RpcClient) SendUserOperation(ctx context.Context, op *UserOperation, entryPoint common.Address) (common.Hash, error) {
 	var result common.Hash
-	err := c.c.CallContext(ctx, &result, "eth_sendUserOperation", op, entryPoint)
+	err := c.call(ctx, &result, "eth_sendUserOperation", op, entryPoint)
 	return result, err
 }
 
-func (c *RpcClient) EstimateUserOperationGas(ctx context.Context, op *userop.UserOperation, entryPoint common.Address) (*gas.GasEstimates, error) {
-	var estimate gas.GasEstimates
-	err := c.c.CallContext(ctx, &estimate, "eth_estimateUserOperationGas", op, entryPoint)
-	if err != nil {
+// SendPackedUserOperation sends a v0.7 op in the unpacked ERC-7769 format.
+func (c *RpcClient) SendPackedUserOperation(ctx context.Context, op *PackedUserOperation, entryPoint common.Address) (common.Hash, error) {
+	var result common.Hash
+	err := c.call(ctx, &result, "eth_sendUserOperation", op, entryPoint)
+	return result, err
+}
+
+// EstimatePackedUserOperationGas is the v0.7 equivalent of
+// EstimateUserOperationGas. The estimate includes the paymaster gas limits
+// if op has a paymaster.
+func (c *RpcClient) EstimatePackedUserOperationGas(ctx context.Context, op *PackedUserOperation, entryPoint common.Address) (*GasEstimates, error) {
+	var estimate GasEstimates
+	if err := c.call(ctx, &estimate, "eth_estimateUserOperationGas", op, entryPoint); err != nil {
 		return nil, err
 	}
 	return &estimate, nil
 }
 
-func (c *RpcClient) EstimateUserOperationGasWithOverrides(ctx context.Context, op *userop.UserOperation, entryPoint common.Address, stateOverrides map[common.Address]OverrideAccount) (*gas.GasEstimates, error) {
-	var estimate gas.GasEstimates
-	err := c.c.CallContext(ctx, &estimate, "eth_estimateUserOperationGas", op, entryPoint, stateOverrides)
+func (c *RpcClient) EstimateUserOperationGas(ctx context.Context, op *UserOperation, entryPoint common.Address) (*GasEstimates, error) {
+	return c.estimateUserOperationGas(ctx, op, entryPoint)
+}
+
+func (c *RpcClient) EstimateUserOperationGasWithOverrides(ctx context.Context, op *UserOperation, entryPoint common.Address, stateOverrides map[common.Address]OverrideAccount) (*GasEstimates, error) {
+	if err := ValidateStateOverrides(stateOverrides); err != nil {
+		return nil, err
+	}
+	return c.estimateUserOperationGas(ctx, op, entryPoint, stateOverrides)
+}
+
+func (c *RpcClient) estimateUserOperationGas(ctx context.Context, op *UserOperation, entryPoint common.Address, overrides ...interface{}) (*GasEstimates, error) {
+	args := append([]interface{}{op, entryPoint}, overrides...)
+	if c.spec == SpecERC7769 {
+		args[0] = estimationOp{op}
+	}
+	var estimate GasEstimates
+	err := c.call(ctx, &estimate, "eth_estimateUserOperationGas", args...)
 	if err != nil {
 		return nil, err
 	}
 	return &estimate, nil
 }
 
-func (c *RpcClient) GetUserOperationReceipt(ctx context.Context, userOpHash common.Hash) (*filter.UserOperationReceipt, error) {
-	var receipt filter.UserOperationReceipt
-	err := c.c.CallContext(ctx, &receipt, "eth_getUserOperationReceipt", userOpHash)
+func (c *RpcClient) GetUserOperationReceipt(ctx context.Context, userOpHash common.Hash) (*UserOperationReceipt, error) {
+	var receipt *UserOperationReceipt
+	err := c.call(ctx, &receipt, "eth_getUserOperationReceipt", userOpHash)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	if c.CompatProfile().TolerantNumbers {
+		normalizeReceipt(receipt)
+	}
+	if err := receipt.DecodeEvents(); err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
+
+func (c *RpcClient) GetUserOperationByHash(ctx context.Context, userOpHash common.Hash) (*HashLookupResult, error) {
+	var op *HashLookupResult
+	err := c.call(ctx, &op, "eth_getUserOperationByHash", userOpHash)
 	if err != nil {
 		return nil, err
 	}
-	return &receipt, nil
+	return op, nil
 }
 
-func (c *RpcClient) GetUserOperationByHash(ctx context.Context, userOpHash common.Hash) (*filter.HashLookupResult, error) {
-	var op filter.HashLookupResult
-	err := c.c.CallContext(ctx, &op, "eth_getUserOperationByHash", userOpHash)
+func (c *RpcClient) GetUserOperationStatus(ctx context.Context, userOpHash common.Hash) (*UserOperationStatus, error) {
+	var status UserOperationStatus
+	err := c.call(ctx, &status, "eth_getUserOperationStatus", userOpHash)
 	if err != nil {
 		return nil, err
 	}
-	return &op, nil
+	if status.Status == "" {
+		status.Status = OpStatusUnknown
+	}
+	return &status, nil
 }
 
 func (c *RpcClient) SupportedEntryPoints(ctx context.Context) ([]common.Address, error) {
+	if c.cache != nil {
+		if entryPoints := c.cache.getEntryPoints(); entryPoints != nil {
+			return entryPoints, nil
+		}
+	}
 	var entryPoints []common.Address
-	err := c.c.CallContext(ctx, &entryPoints, "eth_supportedEntryPoints", []interface{}{}...)
+	err := c.call(ctx, &entryPoints, "eth_supportedEntryPoints", []interface{}{}...)
 	if err != nil {
 		return nil, err
 	}
+	if c.cache != nil {
+		c.cache.setEntryPoints(entryPoints)
+	}
 	return entryPoints, nil
 }
 
 func (c *RpcClient) ChainId(ctx context.Context) (*big.Int, error) {
+	if c.cache != nil {
+		if id := c.cache.getChainId(); id != nil {
+			return id, nil
+		}
+	}
 	var result hexutil.Big
-	err := c.c.CallContext(ctx, &result, "eth_chainId", []interface{}{}...)
+	err := c.call(ctx, &result, "eth_chainId", []interface{}{}...)
 	if err != nil {
 		return nil, err
 	}
+	if c.cache != nil {
+		c.cache.setChainId((*big.Int)(&result))
+	}
 	return (*big.Int)(&result), nil
 }
 
 func (c *RpcClient) BundlerClearState(ctx context.Context) error {
-	return c.c.CallContext(ctx, nil, "debug_bundler_clearState", []interface{}{}...)
+	return c.call(ctx, nil, "debug_bundler_clearState", []interface{}{}...)
 }
 
-func (c *RpcClient) BundlerDumpMempool(ctx context.Context, entryPoint common.Address) ([]*userop.UserOperation, error) {
+func (c *RpcClient) BundlerDumpMempool(ctx context.Context, entryPoint common.Address) ([]*UserOperation, error) {
 	var ops []*UserOperation
-	err := c.c.CallContext(ctx, &ops, "debug_bundler_dumpMempool", entryPoint)
+	err := c.call(ctx, &ops, "debug_bundler_dumpMempool", entryPoint)
 	if err != nil {
 		return nil, err
 	}
-	uops := make([]*userop.UserOperation, len(ops))
-	for i, op := range ops {
-		uops[i] = op.ToUserOperation()
-	}
-	return uops, nil
+	return ops, nil
 }
 
 func (c *RpcClient) BundlerSendBundleNow(ctx context.Context) (*common.Hash, error) {
 	var result string
-	err := c.c.CallContext(ctx, &result, "debug_bundler_sendBundleNow", []interface{}{}...)
+	err := c.call(ctx, &result, "debug_bundler_sendBundleNow", []interface{}{}...)
 	if err != nil {
 		return nil, err
 	}
@@ -145,47 +386,85 @@ func (c *RpcClient) BundlerSendBundleNow(ctx context.Context) (*common.Hash, err
 	return &hash, nil
 }
 
-func (c *RpcClient) BundlerSetBundlingMode(ctx context.Context, mode string) error {
-	return c.c.CallContext(ctx, nil, "debug_bundler_setBundlingMode", mode)
+func (c *RpcClient) BundlerSetBundlingMode(ctx context.Context, mode BundlingMode) error {
+	if !mode.Valid() {
+		return fmt.Errorf("invalid bundling mode %q", mode)
+	}
+	return c.call(ctx, nil, "debug_bundler_setBundlingMode", mode)
 }
 
-type UserOperation struct {
-	Sender               common.Address `json:"sender"`
-	Nonce                *hexutil.Big   `json:"nonce"`
-	InitCode             hexutil.Bytes  `json:"initCode"`
-	CallData             hexutil.Bytes  `json:"callData"`
-	CallGasLimit         *hexutil.Big   `json:"callGasLimit"`
-	VerificationGasLimit *hexutil.Big   `json:"verificationGasLimit"`
-	PreVerificationGas   *hexutil.Big   `json:"preVerificationGas"`
-	MaxFeePerGas         *hexutil.Big   `json:"maxFeePerGas"`
-	MaxPriorityFeePerGas *hexutil.Big   `json:"maxPriorityFeePerGas"`
-	PaymasterAndData     hexutil.Bytes  `json:"paymasterAndData"`
-	Signature            hexutil.Bytes  `json:"signature"`
+func (c *RpcClient) BundlerGetBundlingMode(ctx context.Context) (BundlingMode, error) {
+	var mode BundlingMode
+	err := c.call(ctx, &mode, "debug_bundler_getBundlingMode", []interface{}{}...)
+	if err != nil {
+		return "", err
+	}
+	return mode, nil
 }
 
-func (uo *UserOperation) ToUserOperation() *userop.UserOperation {
-	if uo == nil {
-		return nil
+func (c *RpcClient) BundlerDumpReputation(ctx context.Context, entryPoint common.Address) ([]ReputationEntry, error) {
+	var entries []ReputationEntry
+	err := c.call(ctx, &entries, "debug_bundler_dumpReputation", entryPoint)
+	if err != nil {
+		return nil, err
 	}
-	return &userop.UserOperation{
-		Sender:               uo.Sender,
-		Nonce:                uo.Nonce.ToInt(),
-		InitCode:             uo.InitCode,
-		CallData:             uo.CallData,
-		CallGasLimit:         uo.CallGasLimit.ToInt(),
-		VerificationGasLimit: uo.VerificationGasLimit.ToInt(),
-		PreVerificationGas:   uo.PreVerificationGas.ToInt(),
-		MaxFeePerGas:         uo.MaxFeePerGas.ToInt(),
-		MaxPriorityFeePerGas: uo.MaxPriorityFeePerGas.ToInt(),
-		PaymasterAndData:     uo.PaymasterAndData,
-		Signature:            uo.Signature,
+	return entries, nil
+}
+
+func (c *RpcClient) BundlerSetReputation(ctx context.Context, entries []ReputationEntry, entryPoint common.Address) error {
+	return c.call(ctx, nil, "debug_bundler_setReputation", entries, entryPoint)
+}
+
+func (c *RpcClient) BundlerAddUserOps(ctx context.Context, ops []*UserOperation, entryPoint common.Address) error {
+	return c.call(ctx, nil, "debug_bundler_addUserOps", ops, entryPoint)
+}
+
+func normalizeReceipt(r *UserOperationReceipt) {
+	r.Nonce = normalizeHex(r.Nonce)
+	r.ActualGasCost = normalizeHex(r.ActualGasCost)
+	r.ActualGasUsed = normalizeHex(r.ActualGasUsed)
+	if r.Receipt != nil {
+		r.Receipt.BlockNumber = normalizeHex(r.Receipt.BlockNumber)
+		r.Receipt.CumulativeGasUsed = normalizeHex(r.Receipt.CumulativeGasUsed)
+		r.Receipt.GasUsed = normalizeHex(r.Receipt.GasUsed)
+		r.Receipt.TransactionIndex = normalizeHex(r.Receipt.TransactionIndex)
+		r.Receipt.EffectiveGasPrice = normalizeHex(r.Receipt.EffectiveGasPrice)
 	}
 }
 
+// OverrideAccount is a state override in geth's eth_call schema. State
+// replaces the account's whole storage while StateDiff patches individual
+// slots, so at most one of them may be set. MovePrecompileTo relocates a
+// precompile, making its address free to override with Code.
 type OverrideAccount struct {
-	Nonce     *hexutil.Uint64              `json:"nonce"`
-	Code      *hexutil.Bytes               `json:"code"`
-	Balance   *hexutil.Big                 `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"state"`
-	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+	Nonce            *hexutil.Uint64              `json:"nonce"`
+	Code             *hexutil.Bytes               `json:"code"`
+	Balance          *hexutil.Big                 `json:"balance"`
+	State            *map[common.Hash]common.Hash `json:"state"`
+	StateDiff        *map[common.Hash]common.Hash `json:"stateDiff"`
+	MovePrecompileTo *common.Address              `json:"movePrecompileToAddress,omitempty"`
+}
+
+// ValidateStateOverrides applies the checks geth makes on a state override
+// set that do not need chain state, so invalid sets fail before being sent.
+func ValidateStateOverrides(overrides map[common.Address]OverrideAccount) error {
+	moved := make(map[common.Address]common.Address)
+	for addr, account := range overrides {
+		if account.State != nil && account.StateDiff != nil {
+			return fmt.Errorf("account %s has both 'state' and 'stateDiff'", addr.Hex())
+		}
+		if to := account.MovePrecompileTo; to != nil {
+			if *to == addr {
+				return fmt.Errorf("account %s is moved to itself", addr.Hex())
+			}
+			if from, ok := moved[*to]; ok {
+				return fmt.Errorf("accounts %s and %s are both moved to %s", from.Hex(), addr.Hex(), to.Hex())
+			}
+			if _, ok := overrides[*to]; ok {
+				return fmt.Errorf("account %s is moved to %s, which is already overridden", addr.Hex(), to.Hex())
+			}
+			moved[*to] = addr
+		}
+	}
+	return nil
 }
@@ -0,0 +1,57 @@
+// Command bundlerconform runs the conformance suite against a bundler
+// endpoint and prints a compatibility report. It exits non-zero if any check
+// fails.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+	"time"
+
+	bundler_client "github.com/mdehoog/go-bundler-client"
+	"github.com/mdehoog/go-bundler-client/conformance"
+)
+
+func main() {
+	var (
+		url         = flag.String("url", "http://localhost:4337", "bundler RPC endpoint")
+		opFile      = flag.String("op", "", "optional path to a JSON user operation used for estimation/sending")
+		send        = flag.Bool("send", false, "send the op from -op")
+		debug       = flag.Bool("debug", false, "run debug_bundler_* checks")
+		destructive = flag.Bool("destructive", false, "with -debug, also run checks that change bundler state (bundling mode, bundles, mempool)")
+		timeout     = flag.Duration("timeout", time.Minute, "overall timeout")
+	)
+	flag.Parse()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	c, err := bundler_client.DialContext(ctx, *url)
+	if err != nil {
+		log.Fatalf("Failed to connect to bundler: %v", err)
+	}
+
+	cfg := conformance.Config{Endpoint: *url, Send: *send, Debug: *debug, Destructive: *destructive}
+	if *opFile != "" {
+		b, err := os.ReadFile(*opFile)
+		if err != nil {
+			log.Fatalf("Failed to read op: %v", err)
+		}
+		var op bundler_client.UserOperation
+		if err := json.Unmarshal(b, &op); err != nil {
+			log.Fatalf("Failed to decode op: %v", err)
+		}
+		cfg.Op = &op
+	}
+
+	report := conformance.Run(ctx, c, cfg)
+	if _, err := report.WriteTo(os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+	if !report.Passed() {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	bundler_client "github.com/mdehoog/go-bundler-client"
+)
+
+func init() {
+	commands["dump"] = command{"dump the bundler's mempool (debug namespace)", dump}
+	commands["bundle"] = command{"trigger a bundle or set the bundling mode (debug namespace)", bundle}
+}
+
+func dump(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("dump", flag.ExitOnError)
+	conn := addConnFlags(fs)
+	_ = fs.Parse(args)
+
+	c, err := conn.dial(ctx)
+	if err != nil {
+		return err
+	}
+	ep, err := conn.resolveEntryPoint(ctx, c)
+	if err != nil {
+		return err
+	}
+	ops, err := c.BundlerDumpMempool(ctx, ep)
+	if err != nil {
+		return err
+	}
+	return printJSON(ops)
+}
+
+func bundle(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("bundle", flag.ExitOnError)
+	conn := addConnFlags(fs)
+	mode := fs.String("mode", "", "set the bundling mode (auto or manual) instead of bundling")
+	_ = fs.Parse(args)
+
+	c, err := conn.dial(ctx)
+	if err != nil {
+		return err
+	}
+	if *mode != "" {
+		return c.BundlerSetBundlingMode(ctx, bundler_client.BundlingMode(*mode))
+	}
+	hash, err := c.BundlerSendBundleNow(ctx)
+	if err != nil {
+		return err
+	}
+	if hash == nil {
+		fmt.Println("mempool empty, no bundle sent")
+		return nil
+	}
+	fmt.Println(hash)
+	return nil
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	bundler_client "github.com/mdehoog/go-bundler-client"
+)
+
+func init() {
+	commands["estimate"] = command{"estimate gas for an op, optionally with state overrides", estimate}
+}
+
+func estimate(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("estimate", flag.ExitOnError)
+	conn := addConnFlags(fs)
+	opFile := fs.String("op", "", "path to a JSON user operation")
+	overridesFile := fs.String("overrides", "", "optional path to a JSON state override set")
+	pad := fs.Int("pad", 10, "padding percentage applied to the suggested limits")
+	_ = fs.Parse(args)
+	if *opFile == "" {
+		return errors.New("-op is required")
+	}
+	op, err := readOp(*opFile)
+	if err != nil {
+		return err
+	}
+
+	c, err := conn.dial(ctx)
+	if err != nil {
+		return err
+	}
+	ep, err := conn.resolveEntryPoint(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	var est *bundler_client.GasEstimates
+	if *overridesFile != "" {
+		var overrides map[common.Address]bundler_client.OverrideAccount
+		if err := readJSON(*overridesFile, &overrides); err != nil {
+			return err
+		}
+		est, err = c.EstimateUserOperationGasWithOverrides(ctx, op, ep, overrides)
+	} else {
+		est, err = c.EstimateUserOperationGas(ctx, op, ep)
+	}
+	if err != nil {
+		return err
+	}
+	if missing := bundler_client.MissingEstimateFields(est); len(missing) > 0 {
+		return &bundler_client.MissingFieldError{Fields: missing}
+	}
+
+	fmt.Printf("%-22s %14s %14s\n", "field", "estimate", fmt.Sprintf("padded +%d%%", *pad))
+	row := func(name string, v *big.Int) {
+		fmt.Printf("%-22s %14s %14s\n", name, v, padded(v, *pad))
+	}
+	row("preVerificationGas", est.PreVerificationGas)
+	row("verificationGasLimit", est.VerificationGasLimit)
+	row("callGasLimit", est.CallGasLimit)
+	total := new(big.Int).Add(est.PreVerificationGas, est.VerificationGasLimit)
+	total.Add(total, est.CallGasLimit)
+	row("total", total)
+	if op.MaxFeePerGas != nil && op.MaxFeePerGas.Sign() > 0 {
+		fmt.Printf("\nmax cost at maxFeePerGas %s: %s wei\n", op.MaxFeePerGas, new(big.Int).Mul(padded(total, *pad), op.MaxFeePerGas))
+	}
+	return nil
+}
+
+func padded(v *big.Int, pct int) *big.Int {
+	p := new(big.Int).Mul(v, big.NewInt(int64(100+pct)))
+	return p.Div(p, big.NewInt(100))
+}
+
+func readJSON(path string, v interface{}) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+func readOp(path string) (*bundler_client.UserOperation, error) {
+	var op bundler_client.UserOperation
+	if err := readJSON(path, &op); err != nil {
+		return nil, err
+	}
+	return &op, nil
+}
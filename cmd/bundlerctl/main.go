@@ -0,0 +1,109 @@
+// Command bundlerctl is a small operator CLI wrapping the bundler client.
+//
+// Usage:
+//
+//	bundlerctl <command> [flags]
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	bundler_client "github.com/mdehoog/go-bundler-client"
+)
+
+type command struct {
+	usage string
+	run   func(ctx context.Context, args []string) error
+}
+
+var commands = map[string]command{}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+	cmd, ok := commands[os.Args[1]]
+	if !ok {
+		usage()
+	}
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+	if err := cmd.run(ctx, os.Args[2:]); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: bundlerctl <command> [flags]\n\ncommands:")
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %-10s %s\n", name, commands[name].usage)
+	}
+	os.Exit(2)
+}
+
+// connFlags are the flags shared by all commands.
+type connFlags struct {
+	url        *string
+	entryPoint *string
+	headers    *headerFlags
+}
+
+func addConnFlags(fs *flag.FlagSet) connFlags {
+	f := connFlags{
+		url:        fs.String("url", "http://localhost:4337", "bundler RPC endpoint"),
+		entryPoint: fs.String("entrypoint", "", "entrypoint address (defaults to the bundler's first supported entrypoint)"),
+		headers:    &headerFlags{},
+	}
+	fs.Var(f.headers, "header", "HTTP header sent with every request, as \"Key: Value\" (repeatable)")
+	return f
+}
+
+func (f connFlags) dial(ctx context.Context) (bundler_client.Client, error) {
+	var opts []bundler_client.DialOption
+	for _, h := range *f.headers {
+		key, value, _ := strings.Cut(h, ":")
+		opts = append(opts, bundler_client.WithHeader(strings.TrimSpace(key), strings.TrimSpace(value)))
+	}
+	return bundler_client.DialContext(ctx, *f.url, opts...)
+}
+
+type headerFlags []string
+
+func (h *headerFlags) String() string {
+	return strings.Join(*h, ", ")
+}
+
+func (h *headerFlags) Set(v string) error {
+	if !strings.Contains(v, ":") {
+		return fmt.Errorf("header %q is not of the form \"Key: Value\"", v)
+	}
+	*h = append(*h, v)
+	return nil
+}
+
+func (f connFlags) resolveEntryPoint(ctx context.Context, c bundler_client.Client) (common.Address, error) {
+	if *f.entryPoint != "" {
+		return bundler_client.ParseAddress(*f.entryPoint)
+	}
+	eps, err := c.SupportedEntryPoints(ctx)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if len(eps) == 0 {
+		return common.Address{}, fmt.Errorf("bundler reports no supported entrypoints")
+	}
+	return eps[0], nil
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func init() {
+	commands["send"] = command{"send an op from a JSON file", send}
+	commands["receipt"] = command{"fetch the receipt of an op", receipt}
+}
+
+func send(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("send", flag.ExitOnError)
+	conn := addConnFlags(fs)
+	opFile := fs.String("op", "", "path to a JSON user operation")
+	_ = fs.Parse(args)
+	if *opFile == "" {
+		return errors.New("-op is required")
+	}
+	op, err := readOp(*opFile)
+	if err != nil {
+		return err
+	}
+
+	c, err := conn.dial(ctx)
+	if err != nil {
+		return err
+	}
+	ep, err := conn.resolveEntryPoint(ctx, c)
+	if err != nil {
+		return err
+	}
+	hash, err := c.SendUserOperation(ctx, op, ep)
+	if err != nil {
+		return err
+	}
+	fmt.Println(hash)
+	return nil
+}
+
+func receipt(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("receipt", flag.ExitOnError)
+	conn := addConnFlags(fs)
+	hash := fs.String("hash", "", "userop hash")
+	_ = fs.Parse(args)
+	if *hash == "" {
+		return errors.New("-hash is required")
+	}
+
+	var h common.Hash
+	if err := h.UnmarshalText([]byte(*hash)); err != nil {
+		return fmt.Errorf("invalid -hash: %w", err)
+	}
+
+	c, err := conn.dial(ctx)
+	if err != nil {
+		return err
+	}
+	r, err := c.GetUserOperationReceipt(ctx, h)
+	if err != nil {
+		return err
+	}
+	if r == nil {
+		return fmt.Errorf("no receipt for %s", *hash)
+	}
+	return printJSON(r)
+}
+
+func printJSON(v interface{}) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
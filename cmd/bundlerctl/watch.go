@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"time"
+
+	bundler_client "github.com/mdehoog/go-bundler-client"
+)
+
+func init() {
+	commands["watch"] = command{"stream status transitions for a sender's operations", watch}
+}
+
+func watch(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("watch", flag.ExitOnError)
+	conn := addConnFlags(fs)
+	sender := fs.String("sender", "", "sender address to watch")
+	interval := fs.Duration("interval", 2*time.Second, "polling interval")
+	_ = fs.Parse(args)
+	if *sender == "" {
+		return errors.New("-sender is required")
+	}
+	addr, err := bundler_client.ParseAddress(*sender)
+	if err != nil {
+		return err
+	}
+
+	c, err := conn.dial(ctx)
+	if err != nil {
+		return err
+	}
+	ep, err := conn.resolveEntryPoint(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	w := bundler_client.NewSenderWatcher(c, addr, ep, *interval)
+	w.OnError = func(err error) { log.Printf("poll failed: %v", err) }
+	if err := w.Start(); err != nil {
+		return err
+	}
+	defer w.Stop()
+
+	fmt.Printf("watching %s on entrypoint %s\n", addr, ep)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case t := <-w.Transitions():
+			line := fmt.Sprintf("%s  %-8s  nonce=%s  %s", t.Time.Format(time.RFC3339), t.Status, t.Nonce, t.UserOpHash)
+			if t.Receipt != nil && t.Receipt.Receipt != nil {
+				line += fmt.Sprintf("  tx=%s gasCost=%s", t.Receipt.Receipt.TransactionHash, t.Receipt.ActualGasCost)
+			}
+			fmt.Println(line)
+		}
+	}
+}
@@ -0,0 +1,222 @@
+// Command bundlerload generates synthetic user operation traffic against a
+// bundler endpoint and reports acceptance and inclusion latency percentiles.
+//
+// Operations are derived from a JSON template: each generated op uses its own
+// nonce key (so ops never collide in the mempool) and a priority fee drawn
+// uniformly from the configured range. The template signature is reused
+// as-is, so the target account must accept it for every generated op (e.g. a
+// test account with permissive signature validation).
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"math/big"
+	"math/rand"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	bundler_client "github.com/mdehoog/go-bundler-client"
+)
+
+func main() {
+	var (
+		url         = flag.String("url", "http://localhost:4337", "bundler RPC endpoint")
+		entryPoint  = flag.String("entrypoint", "", "entrypoint address (defaults to the bundler's first supported entrypoint)")
+		opFile      = flag.String("op", "", "path to a JSON user operation template")
+		count       = flag.Int("count", 100, "number of user operations to send")
+		concurrency = flag.Int("concurrency", 10, "number of concurrent senders")
+		nonceKey    = flag.Uint64("nonce-key", 1, "first nonce key to use; each op uses the next key")
+		minTip      = flag.Float64("min-tip", 1, "minimum maxPriorityFeePerGas, in gwei")
+		maxTip      = flag.Float64("max-tip", 2, "maximum maxPriorityFeePerGas, in gwei")
+		seed        = flag.Int64("seed", time.Now().UnixNano(), "random seed for the fee distribution")
+		wait        = flag.Bool("wait", true, "wait for inclusion and report inclusion latency")
+		poll        = flag.Duration("poll", time.Second, "receipt polling interval")
+		timeout     = flag.Duration("timeout", 5*time.Minute, "overall timeout")
+	)
+	flag.Parse()
+
+	if *opFile == "" {
+		log.Fatal("-op is required")
+	}
+	if *count < 1 {
+		log.Fatal("-count must be at least 1")
+	}
+	if *concurrency < 1 {
+		log.Fatal("-concurrency must be at least 1")
+	}
+	template, err := readOp(*opFile)
+	if err != nil {
+		log.Fatalf("Failed to read op template: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	c, err := bundler_client.DialContext(ctx, *url)
+	if err != nil {
+		log.Fatalf("Failed to connect to bundler: %v", err)
+	}
+	ep, err := resolveEntryPoint(ctx, c, *entryPoint)
+	if err != nil {
+		log.Fatalf("Failed to resolve entrypoint: %v", err)
+	}
+
+	rng := rand.New(rand.NewSource(*seed))
+	ops := make([]*bundler_client.UserOperation, *count)
+	for i := range ops {
+		tip := *minTip + rng.Float64()*(*maxTip-*minTip)
+		ops[i] = deriveOp(template, *nonceKey+uint64(i), gwei(tip))
+	}
+
+	results := make([]result, len(ops))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < *concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = run(ctx, c, ops[i], ep, *wait, *poll)
+			}
+		}()
+	}
+	start := time.Now()
+	for i := range ops {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	report(results, time.Since(start))
+}
+
+type result struct {
+	acceptErr  error
+	accept     time.Duration
+	included   bool
+	inclusion  time.Duration
+	inclErr    error
+	opSuccess  bool
+	userOpHash common.Hash
+}
+
+func run(ctx context.Context, c bundler_client.Client, op *bundler_client.UserOperation, ep common.Address, wait bool, poll time.Duration) result {
+	var r result
+	start := time.Now()
+	r.userOpHash, r.acceptErr = c.SendUserOperation(ctx, op, ep)
+	r.accept = time.Since(start)
+	if r.acceptErr != nil || !wait {
+		return r
+	}
+	t := time.NewTicker(poll)
+	defer t.Stop()
+	for {
+		receipt, err := c.GetUserOperationReceipt(ctx, r.userOpHash)
+		if err == nil && receipt != nil && receipt.UserOpHash == r.userOpHash {
+			r.included = true
+			r.inclusion = time.Since(start)
+			r.opSuccess = receipt.Success
+			return r
+		}
+		select {
+		case <-ctx.Done():
+			r.inclErr = ctx.Err()
+			return r
+		case <-t.C:
+		}
+	}
+}
+
+func report(results []result, elapsed time.Duration) {
+	var accepted, included, succeeded int
+	var accept, inclusion []time.Duration
+	errs := make(map[string]int)
+	for _, r := range results {
+		if r.acceptErr != nil {
+			errs[r.acceptErr.Error()]++
+			continue
+		}
+		accepted++
+		accept = append(accept, r.accept)
+		if r.included {
+			included++
+			inclusion = append(inclusion, r.inclusion)
+			if r.opSuccess {
+				succeeded++
+			}
+		}
+	}
+
+	fmt.Printf("sent:      %d in %s (%.1f ops/s)\n", len(results), elapsed.Round(time.Millisecond), float64(len(results))/elapsed.Seconds())
+	fmt.Printf("accepted:  %d\n", accepted)
+	fmt.Printf("included:  %d (%d succeeded)\n", included, succeeded)
+	printPercentiles("acceptance", accept)
+	printPercentiles("inclusion", inclusion)
+	if len(errs) > 0 {
+		fmt.Println("rejections:")
+		for msg, n := range errs {
+			fmt.Printf("  %5d  %s\n", n, msg)
+		}
+	}
+}
+
+func printPercentiles(name string, d []time.Duration) {
+	if len(d) == 0 {
+		return
+	}
+	sort.Slice(d, func(i, j int) bool { return d[i] < d[j] })
+	p := func(q float64) time.Duration {
+		return d[int(q*float64(len(d)-1))].Round(time.Millisecond)
+	}
+	fmt.Printf("%-10s p50=%s p90=%s p99=%s max=%s\n", name+":", p(0.5), p(0.9), p(0.99), d[len(d)-1].Round(time.Millisecond))
+}
+
+func readOp(path string) (*bundler_client.UserOperation, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var op bundler_client.UserOperation
+	if err := json.Unmarshal(b, &op); err != nil {
+		return nil, err
+	}
+	return &op, nil
+}
+
+func deriveOp(template *bundler_client.UserOperation, key uint64, tip *big.Int) *bundler_client.UserOperation {
+	op := *template
+	op.Nonce = new(big.Int).Lsh(new(big.Int).SetUint64(key), 64)
+	op.MaxPriorityFeePerGas = tip
+	if op.MaxFeePerGas == nil || op.MaxFeePerGas.Cmp(tip) < 0 {
+		op.MaxFeePerGas = new(big.Int).Set(tip)
+	}
+	return &op
+}
+
+func resolveEntryPoint(ctx context.Context, c bundler_client.Client, s string) (common.Address, error) {
+	if s != "" {
+		return bundler_client.ParseAddress(s)
+	}
+	eps, err := c.SupportedEntryPoints(ctx)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if len(eps) == 0 {
+		return common.Address{}, fmt.Errorf("bundler reports no supported entrypoints")
+	}
+	return eps[0], nil
+}
+
+func gwei(v float64) *big.Int {
+	f := new(big.Float).Mul(big.NewFloat(v), big.NewFloat(params.GWei))
+	i, _ := f.Int(nil)
+	return i
+}
@@ -0,0 +1,68 @@
+// Command bundlerproxy serves the proxy package's HTTP/JSON API in front of a
+// bundler endpoint. Clients authenticate with one of the bearer tokens listed,
+// comma-separated, in the BUNDLERPROXY_TOKENS environment variable; the proxy
+// refuses to start without any.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	bundler_client "github.com/mdehoog/go-bundler-client"
+	"github.com/mdehoog/go-bundler-client/proxy"
+	"golang.org/x/time/rate"
+)
+
+func main() {
+	var (
+		url        = flag.String("url", "http://localhost:4337", "bundler RPC endpoint")
+		listen     = flag.String("listen", ":8080", "listen address")
+		entryPoint = flag.String("entrypoint", "", "default entrypoint address (defaults to the bundler's first supported entrypoint)")
+		limit      = flag.Float64("rate", 0, "requests per second allowed across all clients (0 disables)")
+		burst      = flag.Int("burst", 10, "rate limiter burst")
+	)
+	flag.Parse()
+
+	ctx := context.Background()
+	c, err := bundler_client.DialContext(ctx, *url)
+	if err != nil {
+		log.Fatalf("Failed to connect to bundler: %v", err)
+	}
+
+	cfg := proxy.Config{Limit: rate.Limit(*limit), Burst: *burst}
+	for _, t := range strings.Split(os.Getenv("BUNDLERPROXY_TOKENS"), ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			cfg.Tokens = append(cfg.Tokens, t)
+		}
+	}
+	if len(cfg.Tokens) == 0 {
+		log.Fatal("No tokens configured: set BUNDLERPROXY_TOKENS to a comma-separated list of bearer tokens")
+	}
+	if *entryPoint != "" {
+		if cfg.EntryPoint, err = bundler_client.ParseAddress(*entryPoint); err != nil {
+			log.Fatal(err)
+		}
+	} else {
+		eps, err := c.SupportedEntryPoints(ctx)
+		if err != nil || len(eps) == 0 {
+			log.Fatalf("Failed to retrieve supported entrypoints: %v", err)
+		}
+		cfg.EntryPoint = eps[0]
+	}
+
+	log.Printf("Proxying %s on %s", bundler_client.EndpointLabel(*url), *listen)
+	srv := &http.Server{
+		Addr:              *listen,
+		Handler:           proxy.NewServer(c, cfg),
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       30 * time.Second,
+		WriteTimeout:      time.Minute,
+		IdleTimeout:       2 * time.Minute,
+	}
+	log.Fatal(srv.ListenAndServe())
+}
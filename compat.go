@@ -0,0 +1,101 @@
+package bundler_client
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+type Vendor string
+
+const (
+	VendorUnknown  Vendor = "unknown"
+	VendorStackup  Vendor = "stackup"
+	VendorRundler  Vendor = "rundler"
+	VendorAlto     Vendor = "alto"
+	VendorSkandha  Vendor = "skandha"
+	VendorVoltaire Vendor = "voltaire"
+	VendorInfinism Vendor = "infinitism"
+)
+
+// CompatProfile captures per-endpoint decoding quirks.
+type CompatProfile struct {
+	// TolerantNumbers normalizes the quantities of receipts returned as
+	// decimal strings to hex. Gas estimates are always decoded tolerantly.
+	TolerantNumbers bool
+}
+
+var (
+	StrictProfile   = CompatProfile{}
+	TolerantProfile = CompatProfile{TolerantNumbers: true}
+)
+
+// ProfileForVendor returns the compatibility profile used for the given
+// vendor. Only Stackup reliably returns receipt quantities as hex, so every
+// other vendor uses tolerant parsing.
+func ProfileForVendor(v Vendor) CompatProfile {
+	if v == VendorStackup {
+		return StrictProfile
+	}
+	return TolerantProfile
+}
+
+var vendorPrefixes = []struct {
+	prefix string
+	vendor Vendor
+}{
+	{"stackup", VendorStackup},
+	{"rundler", VendorRundler},
+	{"alto", VendorAlto},
+	{"skandha", VendorSkandha},
+	{"voltaire", VendorVoltaire},
+	{"aa-bundler", VendorInfinism},
+}
+
+// ParseVendor recognizes the bundler implementation from a web3_clientVersion
+// string.
+func ParseVendor(clientVersion string) Vendor {
+	v := strings.ToLower(clientVersion)
+	for _, p := range vendorPrefixes {
+		if strings.Contains(v, p.prefix) {
+			return p.vendor
+		}
+	}
+	return VendorUnknown
+}
+
+func (c *RpcClient) ClientVersion(ctx context.Context) (string, error) {
+	var version string
+	err := c.call(ctx, &version, "web3_clientVersion", []interface{}{}...)
+	return version, err
+}
+
+// DetectVendor identifies the bundler implementation via web3_clientVersion
+// and switches the client to the matching compatibility profile. Endpoints
+// that do not implement web3_clientVersion are treated as VendorUnknown;
+// any other error is returned, leaving the profile unchanged.
+func (c *RpcClient) DetectVendor(ctx context.Context) (Vendor, error) {
+	version, err := c.ClientVersion(ctx)
+	if err != nil {
+		var rpcErr rpc.Error
+		if !errors.As(err, &rpcErr) || rpcErr.ErrorCode() != methodNotFound {
+			return VendorUnknown, err
+		}
+	}
+	vendor := ParseVendor(version)
+	c.SetCompatProfile(ProfileForVendor(vendor))
+	return vendor, nil
+}
+
+func (c *RpcClient) SetCompatProfile(p CompatProfile) {
+	c.compat.Store(&p)
+}
+
+func (c *RpcClient) CompatProfile() CompatProfile {
+	if p := c.compat.Load(); p != nil {
+		return *p
+	}
+	return StrictProfile
+}
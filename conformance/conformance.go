@@ -0,0 +1,211 @@
+// Package conformance exercises the standard ERC-4337 eth_* and
+// debug_bundler_* RPC methods against an arbitrary bundler endpoint and
+// produces a compatibility report.
+package conformance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+	bundler_client "github.com/mdehoog/go-bundler-client"
+)
+
+type Status string
+
+const (
+	StatusPass        Status = "PASS"
+	StatusFail        Status = "FAIL"
+	StatusUnsupported Status = "UNSUPPORTED"
+	StatusSkipped     Status = "SKIPPED"
+)
+
+// methodNotFound is the JSON-RPC error code for unknown methods.
+const methodNotFound = -32601
+
+type Result struct {
+	Method   string
+	Status   Status
+	Duration time.Duration
+	Detail   string
+}
+
+type Report struct {
+	Endpoint string
+	Results  []Result
+}
+
+// Passed reports whether no check failed. Unsupported and skipped checks do
+// not count as failures.
+func (r *Report) Passed() bool {
+	for _, res := range r.Results {
+		if res.Status == StatusFail {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *Report) WriteTo(w io.Writer) (int64, error) {
+	var n int64
+	write := func(format string, args ...interface{}) error {
+		m, err := fmt.Fprintf(w, format, args...)
+		n += int64(m)
+		return err
+	}
+	if err := write("Conformance report for %s\n", r.Endpoint); err != nil {
+		return n, err
+	}
+	for _, res := range r.Results {
+		if err := write("  %-12s %-38s %8s  %s\n", res.Status, res.Method, res.Duration.Round(time.Millisecond), res.Detail); err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+type Config struct {
+	// Endpoint is recorded in the report for reference only, without its
+	// credentials, path and query, which may hold API keys.
+	Endpoint string
+	// Op is an optional valid user operation used to exercise estimation and,
+	// if Send is set, submission.
+	Op   *bundler_client.UserOperation
+	Send bool
+	// Debug enables the read-only debug_bundler_* checks. Destructive
+	// additionally enables those that change bundler state: switching to
+	// manual bundling (restoring the previous mode afterwards), forcing a
+	// bundle and debug_bundler_clearState.
+	Debug       bool
+	Destructive bool
+}
+
+// Run executes the conformance checks against c.
+func Run(ctx context.Context, c bundler_client.Client, cfg Config) *Report {
+	r := &Report{Endpoint: bundler_client.EndpointLabel(cfg.Endpoint)}
+	check := func(method string, f func() (string, error)) {
+		start := time.Now()
+		detail, err := f()
+		res := Result{Method: method, Duration: time.Since(start), Detail: detail, Status: StatusPass}
+		if err != nil {
+			res.Status = StatusFail
+			res.Detail = err.Error()
+			var rpcErr rpc.Error
+			if errors.As(err, &rpcErr) && rpcErr.ErrorCode() == methodNotFound {
+				res.Status = StatusUnsupported
+			}
+		}
+		r.Results = append(r.Results, res)
+	}
+	skip := func(method, reason string) {
+		r.Results = append(r.Results, Result{Method: method, Status: StatusSkipped, Detail: reason})
+	}
+
+	check("eth_chainId", func() (string, error) {
+		id, err := c.ChainId(ctx)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("chainId=%d", id), nil
+	})
+
+	var entryPoint common.Address
+	check("eth_supportedEntryPoints", func() (string, error) {
+		eps, err := c.SupportedEntryPoints(ctx)
+		if err != nil {
+			return "", err
+		}
+		if len(eps) == 0 {
+			return "", errors.New("no entrypoints returned")
+		}
+		entryPoint = eps[0]
+		return fmt.Sprintf("%d entrypoint(s), first=%s", len(eps), entryPoint), nil
+	})
+
+	unknown := common.HexToHash("0x01")
+	check("eth_getUserOperationByHash", func() (string, error) {
+		_, err := c.GetUserOperationByHash(ctx, unknown)
+		return "unknown hash lookup", err
+	})
+	check("eth_getUserOperationReceipt", func() (string, error) {
+		_, err := c.GetUserOperationReceipt(ctx, unknown)
+		return "unknown hash lookup", err
+	})
+
+	if cfg.Op == nil {
+		skip("eth_estimateUserOperationGas", "no op configured")
+		skip("eth_sendUserOperation", "no op configured")
+	} else {
+		check("eth_estimateUserOperationGas", func() (string, error) {
+			est, err := c.EstimateUserOperationGas(ctx, cfg.Op, entryPoint)
+			if err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("pvg=%v vgl=%v cgl=%v", est.PreVerificationGas, est.VerificationGasLimit, est.CallGasLimit), nil
+		})
+		if cfg.Send {
+			check("eth_sendUserOperation", func() (string, error) {
+				hash, err := c.SendUserOperation(ctx, cfg.Op, entryPoint)
+				if err != nil {
+					return "", err
+				}
+				return "userOpHash=" + hash.Hex(), nil
+			})
+		} else {
+			skip("eth_sendUserOperation", "sending disabled")
+		}
+	}
+
+	if !cfg.Debug {
+		for _, m := range []string{"debug_bundler_dumpMempool", "debug_bundler_getBundlingMode", "debug_bundler_setBundlingMode", "debug_bundler_sendBundleNow", "debug_bundler_clearState"} {
+			skip(m, "debug checks disabled")
+		}
+		return r
+	}
+	check("debug_bundler_dumpMempool", func() (string, error) {
+		ops, err := c.BundlerDumpMempool(ctx, entryPoint)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%d op(s)", len(ops)), nil
+	})
+	if !cfg.Destructive {
+		for _, m := range []string{"debug_bundler_getBundlingMode", "debug_bundler_setBundlingMode", "debug_bundler_sendBundleNow", "debug_bundler_clearState"} {
+			skip(m, "destructive checks disabled")
+		}
+		return r
+	}
+
+	// The bundler is left in the mode it was found in, or in auto mode if
+	// that cannot be read.
+	previous := bundler_client.BundlingModeAuto
+	check("debug_bundler_getBundlingMode", func() (string, error) {
+		mode, err := c.BundlerGetBundlingMode(ctx)
+		if err != nil {
+			return "", err
+		}
+		previous = mode
+		return string(mode), nil
+	})
+	check("debug_bundler_setBundlingMode", func() (string, error) {
+		return string(bundler_client.BundlingModeManual), c.BundlerSetBundlingMode(ctx, bundler_client.BundlingModeManual)
+	})
+	check("debug_bundler_sendBundleNow", func() (string, error) {
+		hash, err := c.BundlerSendBundleNow(ctx)
+		if err != nil || hash == nil {
+			return "no bundle", err
+		}
+		return "tx=" + hash.Hex(), nil
+	})
+	check("debug_bundler_setBundlingMode", func() (string, error) {
+		return "restored " + string(previous), c.BundlerSetBundlingMode(ctx, previous)
+	})
+	check("debug_bundler_clearState", func() (string, error) {
+		return "", c.BundlerClearState(ctx)
+	})
+	return r
+}
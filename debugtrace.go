@@ -0,0 +1,61 @@
+package bundler_client
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// CallFrame is a node of the call tree returned by geth's callTracer.
+type CallFrame struct {
+	Type         string          `json:"type"`
+	From         common.Address  `json:"from"`
+	To           *common.Address `json:"to,omitempty"`
+	Value        *hexutil.Big    `json:"value,omitempty"`
+	Gas          hexutil.Uint64  `json:"gas"`
+	GasUsed      hexutil.Uint64  `json:"gasUsed"`
+	Input        hexutil.Bytes   `json:"input"`
+	Output       hexutil.Bytes   `json:"output,omitempty"`
+	Error        string          `json:"error,omitempty"`
+	RevertReason string          `json:"revertReason,omitempty"`
+	Calls        []*CallFrame    `json:"calls,omitempty"`
+}
+
+// FirstRevert returns the innermost failed frame along the first failing
+// path from f, where the failure originated, or nil if no frame failed.
+func (f *CallFrame) FirstRevert() *CallFrame {
+	if f.Error == "" {
+		return nil
+	}
+	for _, call := range f.Calls {
+		if r := call.FirstRevert(); r != nil {
+			return r
+		}
+	}
+	return f
+}
+
+// TraceUserOperation replays op through a v0.6 EntryPoint's simulateHandleOp
+// with debug_traceCall and the callTracer, returning the call tree of its
+// validation and execution. simulateHandleOp always reverts, so the root
+// frame fails; use FirstRevert on the account, factory or paymaster frames
+// to find where the op itself failed. The node, or the bundler proxying to
+// it, must expose the debug namespace.
+func (c *RpcClient) TraceUserOperation(ctx context.Context, op *UserOperation, entryPoint common.Address) (*CallFrame, error) {
+	input, err := entryPointV06SimulateHandleOp.Pack("simulateHandleOp", op, common.Address{}, []byte{})
+	if err != nil {
+		return nil, err
+	}
+	msg := map[string]interface{}{
+		"from": common.Address{},
+		"to":   entryPoint,
+		"data": hexutil.Bytes(input),
+	}
+	var frame CallFrame
+	err = c.call(ctx, &frame, "debug_traceCall", msg, "latest", map[string]interface{}{"tracer": "callTracer"})
+	if err != nil {
+		return nil, err
+	}
+	return &frame, nil
+}
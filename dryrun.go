@@ -0,0 +1,56 @@
+package bundler_client
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// DryRunResult is the outcome of DryRun. Op is a copy of the input op with
+// the estimated gas fields filled in. If validation failed, ValidationErr is
+// the *FailedOpError or bundler error explaining why and Validation is nil.
+type DryRunResult struct {
+	Op            *UserOperation
+	Estimates     *GasEstimates
+	Validation    *ValidationResult
+	ValidationErr error
+}
+
+// OK reports whether the op passed validation.
+func (r *DryRunResult) OK() bool {
+	return r.ValidationErr == nil
+}
+
+// DryRun estimates gas for op and validates the op with the estimates filled
+// in, without sending it, as a pre-flight check. Validation uses the
+// bundler's eth_validateUserOperation where supported, and simulateValidation
+// over the same connection otherwise, which requires a v0.6 EntryPoint.
+// Since op's signature usually commits to the gas fields, a failed signature
+// check is expected unless op carries a dummy signature the account accepts.
+// Errors from the estimation, or that prevented validation from running,
+// are returned as the error.
+func (c *RpcClient) DryRun(ctx context.Context, op *UserOperation, entryPoint common.Address) (*DryRunResult, error) {
+	estimates, err := c.EstimateUserOperationGas(ctx, op, entryPoint)
+	if err != nil {
+		return nil, err
+	}
+	filled := *op
+	filled.PreVerificationGas = estimates.PreVerificationGas
+	filled.VerificationGasLimit = estimates.VerificationGasLimit
+	filled.CallGasLimit = estimates.CallGasLimit
+	r := &DryRunResult{Op: &filled, Estimates: estimates}
+
+	r.Validation, err = NewPreflighter(c, c, entryPoint).Preflight(ctx, &filled)
+	var failedOp *FailedOpError
+	var rpcErr rpc.Error
+	switch {
+	case err == nil:
+	case errors.As(err, &failedOp), errors.As(err, &rpcErr):
+		r.ValidationErr = err
+	default:
+		return nil, err
+	}
+	return r, nil
+}
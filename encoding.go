@@ -0,0 +1,181 @@
+package bundler_client
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+var (
+	abiBytes32, _ = abi.NewType("bytes32", "", nil)
+	abiUint256, _ = abi.NewType("uint256", "", nil)
+	abiAddress, _ = abi.NewType("address", "", nil)
+
+	userOpArgs       = abi.Arguments{{Type: UserOpType}}
+	packedUserOpArgs = abi.Arguments{{Type: *handleOpsV07.Methods["handleOps"].Inputs[0].Type.Elem}}
+)
+
+// PackUserOperation returns the canonical ABI encoding of a v0.6 op, i.e.
+// abi.encode(UserOperation) exactly as the EntryPoint receives it in
+// handleOps calldata. Unset quantities are encoded as zero.
+func PackUserOperation(op *UserOperation) ([]byte, error) {
+	return userOpArgs.Pack(zeroNilQuantities(op))
+}
+
+// zeroNilQuantities returns a copy of op with its nil quantities set to zero,
+// as the ABI encoder rejects nil *big.Int values.
+func zeroNilQuantities(op *UserOperation) *UserOperation {
+	tmp := *op
+	for _, q := range []**big.Int{
+		&tmp.Nonce, &tmp.CallGasLimit, &tmp.VerificationGasLimit, &tmp.PreVerificationGas,
+		&tmp.MaxFeePerGas, &tmp.MaxPriorityFeePerGas,
+	} {
+		if *q == nil {
+			*q = new(big.Int)
+		}
+	}
+	return &tmp
+}
+
+// uint256Word returns the 32-byte ABI word of v, treating nil as zero.
+func uint256Word(v *big.Int) []byte {
+	if v == nil {
+		return make([]byte, 32)
+	}
+	return math.U256Bytes(new(big.Int).Set(v))
+}
+
+// UnpackUserOperation decodes the output of PackUserOperation.
+func UnpackUserOperation(data []byte) (*UserOperation, error) {
+	values, err := userOpArgs.Unpack(data)
+	if err != nil {
+		return nil, err
+	}
+	return abi.ConvertType(values[0], new(UserOperation)).(*UserOperation), nil
+}
+
+// PackPackedUserOperation returns the canonical ABI encoding of a v0.7 op,
+// i.e. abi.encode(PackedUserOperation).
+func PackPackedUserOperation(op *PackedUserOperation) ([]byte, error) {
+	return packedUserOpArgs.Pack(op)
+}
+
+// UnpackPackedUserOperation decodes the output of PackPackedUserOperation.
+func UnpackPackedUserOperation(data []byte) (*PackedUserOperation, error) {
+	values, err := packedUserOpArgs.Unpack(data)
+	if err != nil {
+		return nil, err
+	}
+	return abi.ConvertType(values[0], new(PackedUserOperation)).(*PackedUserOperation), nil
+}
+
+// PackUserOperationForHash returns the encoding of a v0.6 op that the
+// EntryPoint hashes in getUserOpHash: the op without its signature, with the
+// dynamic byte fields replaced by their keccak256 hashes.
+func PackUserOperationForHash(op *UserOperation) []byte {
+	return op.PackForSignature()
+}
+
+var packedUserOpHashArgs = abi.Arguments{
+	{Type: abiAddress}, {Type: abiUint256}, {Type: abiBytes32}, {Type: abiBytes32},
+	{Type: abiBytes32}, {Type: abiUint256}, {Type: abiBytes32}, {Type: abiBytes32},
+}
+
+// PackPackedUserOperationForHash is the v0.7 equivalent of
+// PackUserOperationForHash.
+func PackPackedUserOperationForHash(op *PackedUserOperation) ([]byte, error) {
+	return packedUserOpHashArgs.Pack(
+		op.Sender,
+		op.Nonce,
+		crypto.Keccak256Hash(op.InitCode),
+		crypto.Keccak256Hash(op.CallData),
+		op.AccountGasLimits,
+		op.PreVerificationGas,
+		op.GasFees,
+		crypto.Keccak256Hash(op.PaymasterAndData),
+	)
+}
+
+// rlpUserOperation is the compact binary form of a v0.6 op. Eip7702Auth is
+// a trailing optional element, so that encodings of ops without it are
+// unchanged.
+type rlpUserOperation struct {
+	Sender               common.Address
+	Nonce                *big.Int
+	InitCode             []byte
+	CallData             []byte
+	CallGasLimit         *big.Int
+	VerificationGasLimit *big.Int
+	PreVerificationGas   *big.Int
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	PaymasterAndData     []byte
+	Signature            []byte
+	Eip7702Auth          *rlpEip7702Auth `rlp:"optional"`
+}
+
+type rlpEip7702Auth struct {
+	ChainId *big.Int
+	Address common.Address
+	Nonce   uint64
+	YParity uint64
+	R       *big.Int
+	S       *big.Int
+}
+
+// EncodeUserOperationCompact returns a compact RLP encoding of op for storage
+// and transport. Unlike PackUserOperation, it is not understood on-chain.
+func EncodeUserOperationCompact(op *UserOperation) ([]byte, error) {
+	enc := &rlpUserOperation{
+		op.Sender, op.Nonce, op.InitCode, op.CallData, op.CallGasLimit, op.VerificationGasLimit,
+		op.PreVerificationGas, op.MaxFeePerGas, op.MaxPriorityFeePerGas, op.PaymasterAndData, op.Signature, nil,
+	}
+	if a := op.Eip7702Auth; a != nil {
+		enc.Eip7702Auth = &rlpEip7702Auth{
+			ChainId: a.ChainId.ToInt(),
+			Address: a.Address,
+			Nonce:   uint64(a.Nonce),
+			YParity: uint64(a.YParity),
+			R:       a.R.ToInt(),
+			S:       a.S.ToInt(),
+		}
+	}
+	return rlp.EncodeToBytes(enc)
+}
+
+// DecodeUserOperationCompact decodes the output of EncodeUserOperationCompact.
+func DecodeUserOperationCompact(data []byte) (*UserOperation, error) {
+	var dec rlpUserOperation
+	if err := rlp.DecodeBytes(data, &dec); err != nil {
+		return nil, err
+	}
+	op := &UserOperation{
+		Sender:               dec.Sender,
+		Nonce:                dec.Nonce,
+		InitCode:             dec.InitCode,
+		CallData:             dec.CallData,
+		CallGasLimit:         dec.CallGasLimit,
+		VerificationGasLimit: dec.VerificationGasLimit,
+		PreVerificationGas:   dec.PreVerificationGas,
+		MaxFeePerGas:         dec.MaxFeePerGas,
+		MaxPriorityFeePerGas: dec.MaxPriorityFeePerGas,
+		PaymasterAndData:     dec.PaymasterAndData,
+		Signature:            dec.Signature,
+	}
+	if a := dec.Eip7702Auth; a != nil {
+		op.Eip7702Auth = &Eip7702Auth{
+			ChainId: (*hexutil.Big)(a.ChainId),
+			Address: a.Address,
+			Nonce:   hexutil.Uint64(a.Nonce),
+			YParity: hexutil.Uint64(a.YParity),
+			R:       (*hexutil.Big)(a.R),
+			S:       (*hexutil.Big)(a.S),
+		}
+	}
+	return op, nil
+}
@@ -0,0 +1,88 @@
+package bundler_client
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type EntryPointVersion string
+
+const (
+	EntryPointVersion06 EntryPointVersion = "v0.6"
+	EntryPointVersion07 EntryPointVersion = "v0.7"
+	EntryPointVersion08 EntryPointVersion = "v0.8"
+)
+
+var (
+	EntryPointV06 = common.HexToAddress("0x5FF137D4b0FDCD49DcA30c7CF57E578a026d2789")
+	EntryPointV07 = common.HexToAddress("0x0000000071727De22E5E9d8BAf0edAc6f37da032")
+	EntryPointV08 = common.HexToAddress("0x4337084D9E255Ff0702461CF8895CE9E3b5Ff108")
+)
+
+var ErrNoKnownEntryPoint = errors.New("bundler supports no canonical entrypoint")
+
+var entryPointVersions = map[common.Address]EntryPointVersion{
+	EntryPointV06: EntryPointVersion06,
+	EntryPointV07: EntryPointVersion07,
+	EntryPointV08: EntryPointVersion08,
+}
+
+// EntryPointVersionOf returns the version of a canonical EntryPoint
+// deployment, or false if address is not one.
+func EntryPointVersionOf(address common.Address) (EntryPointVersion, bool) {
+	v, ok := entryPointVersions[address]
+	return v, ok
+}
+
+// EntryPoint is an EntryPoint address tagged with its version.
+type EntryPoint struct {
+	Address common.Address
+	Version EntryPointVersion
+}
+
+// Packed reports whether the EntryPoint takes ops in the PackedUserOperation
+// format introduced in v0.7.
+func (e EntryPoint) Packed() bool {
+	return e.Version != EntryPointVersion06
+}
+
+// PackedUserOpHash returns the hash the EntryPoint assigns to op, using the
+// EIP-712 scheme of v0.8 or the v0.7 one otherwise. For v0.6 EntryPoints use
+// GetUserOpHash with a UserOperation.
+func (e EntryPoint) PackedUserOpHash(op *PackedUserOperation, chainID *big.Int) (common.Hash, error) {
+	if e.Version == EntryPointVersion08 {
+		return GetUserOpHashV08(op, e.Address, chainID)
+	}
+	return GetPackedUserOpHash(op, e.Address, chainID)
+}
+
+// SelectEntryPoint picks one of the bundler's supported entrypoints that is a
+// canonical deployment, taking the first of prefer the bundler supports. If
+// prefer is empty, the oldest version is preferred: v0.6 is the only one the
+// Client methods take as is, and v0.7 and v0.8 ops must be built and sent
+// through the PackedUserOperation paths. It returns ErrNoKnownEntryPoint if
+// none match.
+func SelectEntryPoint(ctx context.Context, c EthClient, prefer ...EntryPointVersion) (*EntryPoint, error) {
+	supported, err := c.SupportedEntryPoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byVersion := make(map[EntryPointVersion]common.Address)
+	for _, addr := range supported {
+		if v, ok := EntryPointVersionOf(addr); ok {
+			byVersion[v] = addr
+		}
+	}
+	if len(prefer) == 0 {
+		prefer = []EntryPointVersion{EntryPointVersion06, EntryPointVersion07, EntryPointVersion08}
+	}
+	for _, v := range prefer {
+		if addr, ok := byVersion[v]; ok {
+			return &EntryPoint{Address: addr, Version: v}, nil
+		}
+	}
+	return nil, ErrNoKnownEntryPoint
+}
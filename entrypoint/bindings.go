@@ -0,0 +1,1227 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package entrypoint
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// IEntryPointAggregatorStakeInfo is an auto generated low-level Go binding around an user-defined struct.
+type IEntryPointAggregatorStakeInfo struct {
+	Aggregator common.Address
+	StakeInfo  IStakeManagerStakeInfo
+}
+
+// IEntryPointReturnInfo is an auto generated low-level Go binding around an user-defined struct.
+type IEntryPointReturnInfo struct {
+	PreOpGas                *big.Int
+	Prefund                 *big.Int
+	AccountValidationData   *big.Int
+	PaymasterValidationData *big.Int
+	PaymasterContext        []byte
+}
+
+// IEntryPointSimulationsExecutionResult is an auto generated low-level Go binding around an user-defined struct.
+type IEntryPointSimulationsExecutionResult struct {
+	PreOpGas                *big.Int
+	Paid                    *big.Int
+	AccountValidationData   *big.Int
+	PaymasterValidationData *big.Int
+	TargetSuccess           bool
+	TargetResult            []byte
+}
+
+// IEntryPointSimulationsValidationResult is an auto generated low-level Go binding around an user-defined struct.
+type IEntryPointSimulationsValidationResult struct {
+	ReturnInfo     IEntryPointReturnInfo
+	SenderInfo     IStakeManagerStakeInfo
+	FactoryInfo    IStakeManagerStakeInfo
+	PaymasterInfo  IStakeManagerStakeInfo
+	AggregatorInfo IEntryPointAggregatorStakeInfo
+}
+
+// IStakeManagerStakeInfo is an auto generated low-level Go binding around an user-defined struct.
+type IStakeManagerStakeInfo struct {
+	Stake           *big.Int
+	UnstakeDelaySec *big.Int
+}
+
+// PackedUserOperation is an auto generated low-level Go binding around an user-defined struct.
+type PackedUserOperation struct {
+	Sender             common.Address
+	Nonce              *big.Int
+	InitCode           []byte
+	CallData           []byte
+	AccountGasLimits   [32]byte
+	PreVerificationGas *big.Int
+	GasFees            [32]byte
+	PaymasterAndData   []byte
+	Signature          []byte
+}
+
+// UserOperation is an auto generated low-level Go binding around an user-defined struct.
+type UserOperation struct {
+	Sender               common.Address
+	Nonce                *big.Int
+	InitCode             []byte
+	CallData             []byte
+	CallGasLimit         *big.Int
+	VerificationGasLimit *big.Int
+	PreVerificationGas   *big.Int
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	PaymasterAndData     []byte
+	Signature            []byte
+}
+
+// EntryPointSimulationsMetaData contains all meta data concerning the EntryPointSimulations contract.
+var EntryPointSimulationsMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"simulateHandleOp\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"op\",\"type\":\"tuple\",\"internalType\":\"structPackedUserOperation\",\"components\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"nonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"initCode\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"accountGasLimits\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"preVerificationGas\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"gasFees\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"paymasterAndData\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"signature\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]},{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"targetCallData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structIEntryPointSimulations.ExecutionResult\",\"components\":[{\"name\":\"preOpGas\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"paid\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"accountValidationData\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"paymasterValidationData\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"targetSuccess\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"targetResult\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}]},{\"type\":\"function\",\"name\":\"simulateValidation\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"userOp\",\"type\":\"tuple\",\"internalType\":\"structPackedUserOperation\",\"components\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"nonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"initCode\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"accountGasLimits\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"preVerificationGas\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"gasFees\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"paymasterAndData\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"signature\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structIEntryPointSimulations.ValidationResult\",\"components\":[{\"name\":\"returnInfo\",\"type\":\"tuple\",\"internalType\":\"structIEntryPoint.ReturnInfo\",\"components\":[{\"name\":\"preOpGas\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"prefund\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"accountValidationData\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"paymasterValidationData\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"paymasterContext\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]},{\"name\":\"senderInfo\",\"type\":\"tuple\",\"internalType\":\"structIStakeManager.StakeInfo\",\"components\":[{\"name\":\"stake\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"unstakeDelaySec\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"name\":\"factoryInfo\",\"type\":\"tuple\",\"internalType\":\"structIStakeManager.StakeInfo\",\"components\":[{\"name\":\"stake\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"unstakeDelaySec\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"name\":\"paymasterInfo\",\"type\":\"tuple\",\"internalType\":\"structIStakeManager.StakeInfo\",\"components\":[{\"name\":\"stake\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"unstakeDelaySec\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"name\":\"aggregatorInfo\",\"type\":\"tuple\",\"internalType\":\"structIEntryPoint.AggregatorStakeInfo\",\"components\":[{\"name\":\"aggregator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"stakeInfo\",\"type\":\"tuple\",\"internalType\":\"structIStakeManager.StakeInfo\",\"components\":[{\"name\":\"stake\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"unstakeDelaySec\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]}]}]}]}]",
+}
+
+// EntryPointSimulationsABI is the input ABI used to generate the binding from.
+// Deprecated: Use EntryPointSimulationsMetaData.ABI instead.
+var EntryPointSimulationsABI = EntryPointSimulationsMetaData.ABI
+
+// EntryPointSimulations is an auto generated Go binding around an Ethereum contract.
+type EntryPointSimulations struct {
+	EntryPointSimulationsCaller     // Read-only binding to the contract
+	EntryPointSimulationsTransactor // Write-only binding to the contract
+	EntryPointSimulationsFilterer   // Log filterer for contract events
+}
+
+// EntryPointSimulationsCaller is an auto generated read-only Go binding around an Ethereum contract.
+type EntryPointSimulationsCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EntryPointSimulationsTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type EntryPointSimulationsTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EntryPointSimulationsFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type EntryPointSimulationsFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EntryPointSimulationsSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type EntryPointSimulationsSession struct {
+	Contract     *EntryPointSimulations // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts          // Call options to use throughout this session
+	TransactOpts bind.TransactOpts      // Transaction auth options to use throughout this session
+}
+
+// EntryPointSimulationsCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type EntryPointSimulationsCallerSession struct {
+	Contract *EntryPointSimulationsCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                // Call options to use throughout this session
+}
+
+// EntryPointSimulationsTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type EntryPointSimulationsTransactorSession struct {
+	Contract     *EntryPointSimulationsTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                // Transaction auth options to use throughout this session
+}
+
+// EntryPointSimulationsRaw is an auto generated low-level Go binding around an Ethereum contract.
+type EntryPointSimulationsRaw struct {
+	Contract *EntryPointSimulations // Generic contract binding to access the raw methods on
+}
+
+// EntryPointSimulationsCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type EntryPointSimulationsCallerRaw struct {
+	Contract *EntryPointSimulationsCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// EntryPointSimulationsTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type EntryPointSimulationsTransactorRaw struct {
+	Contract *EntryPointSimulationsTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewEntryPointSimulations creates a new instance of EntryPointSimulations, bound to a specific deployed contract.
+func NewEntryPointSimulations(address common.Address, backend bind.ContractBackend) (*EntryPointSimulations, error) {
+	contract, err := bindEntryPointSimulations(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &EntryPointSimulations{EntryPointSimulationsCaller: EntryPointSimulationsCaller{contract: contract}, EntryPointSimulationsTransactor: EntryPointSimulationsTransactor{contract: contract}, EntryPointSimulationsFilterer: EntryPointSimulationsFilterer{contract: contract}}, nil
+}
+
+// NewEntryPointSimulationsCaller creates a new read-only instance of EntryPointSimulations, bound to a specific deployed contract.
+func NewEntryPointSimulationsCaller(address common.Address, caller bind.ContractCaller) (*EntryPointSimulationsCaller, error) {
+	contract, err := bindEntryPointSimulations(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &EntryPointSimulationsCaller{contract: contract}, nil
+}
+
+// NewEntryPointSimulationsTransactor creates a new write-only instance of EntryPointSimulations, bound to a specific deployed contract.
+func NewEntryPointSimulationsTransactor(address common.Address, transactor bind.ContractTransactor) (*EntryPointSimulationsTransactor, error) {
+	contract, err := bindEntryPointSimulations(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &EntryPointSimulationsTransactor{contract: contract}, nil
+}
+
+// NewEntryPointSimulationsFilterer creates a new log filterer instance of EntryPointSimulations, bound to a specific deployed contract.
+func NewEntryPointSimulationsFilterer(address common.Address, filterer bind.ContractFilterer) (*EntryPointSimulationsFilterer, error) {
+	contract, err := bindEntryPointSimulations(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &EntryPointSimulationsFilterer{contract: contract}, nil
+}
+
+// bindEntryPointSimulations binds a generic wrapper to an already deployed contract.
+func bindEntryPointSimulations(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := EntryPointSimulationsMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_EntryPointSimulations *EntryPointSimulationsRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _EntryPointSimulations.Contract.EntryPointSimulationsCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_EntryPointSimulations *EntryPointSimulationsRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _EntryPointSimulations.Contract.EntryPointSimulationsTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_EntryPointSimulations *EntryPointSimulationsRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _EntryPointSimulations.Contract.EntryPointSimulationsTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_EntryPointSimulations *EntryPointSimulationsCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _EntryPointSimulations.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_EntryPointSimulations *EntryPointSimulationsTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _EntryPointSimulations.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_EntryPointSimulations *EntryPointSimulationsTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _EntryPointSimulations.Contract.contract.Transact(opts, method, params...)
+}
+
+// SimulateHandleOp is a free data retrieval call binding the contract method 0x97b2dcb9.
+//
+// Solidity: function simulateHandleOp((address,uint256,bytes,bytes,bytes32,uint256,bytes32,bytes,bytes) op, address target, bytes targetCallData) view returns((uint256,uint256,uint256,uint256,bool,bytes))
+func (_EntryPointSimulations *EntryPointSimulationsCaller) SimulateHandleOp(opts *bind.CallOpts, op PackedUserOperation, target common.Address, targetCallData []byte) (IEntryPointSimulationsExecutionResult, error) {
+	var out []interface{}
+	err := _EntryPointSimulations.contract.Call(opts, &out, "simulateHandleOp", op, target, targetCallData)
+
+	if err != nil {
+		return *new(IEntryPointSimulationsExecutionResult), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(IEntryPointSimulationsExecutionResult)).(*IEntryPointSimulationsExecutionResult)
+
+	return out0, err
+
+}
+
+// SimulateHandleOp is a free data retrieval call binding the contract method 0x97b2dcb9.
+//
+// Solidity: function simulateHandleOp((address,uint256,bytes,bytes,bytes32,uint256,bytes32,bytes,bytes) op, address target, bytes targetCallData) view returns((uint256,uint256,uint256,uint256,bool,bytes))
+func (_EntryPointSimulations *EntryPointSimulationsSession) SimulateHandleOp(op PackedUserOperation, target common.Address, targetCallData []byte) (IEntryPointSimulationsExecutionResult, error) {
+	return _EntryPointSimulations.Contract.SimulateHandleOp(&_EntryPointSimulations.CallOpts, op, target, targetCallData)
+}
+
+// SimulateHandleOp is a free data retrieval call binding the contract method 0x97b2dcb9.
+//
+// Solidity: function simulateHandleOp((address,uint256,bytes,bytes,bytes32,uint256,bytes32,bytes,bytes) op, address target, bytes targetCallData) view returns((uint256,uint256,uint256,uint256,bool,bytes))
+func (_EntryPointSimulations *EntryPointSimulationsCallerSession) SimulateHandleOp(op PackedUserOperation, target common.Address, targetCallData []byte) (IEntryPointSimulationsExecutionResult, error) {
+	return _EntryPointSimulations.Contract.SimulateHandleOp(&_EntryPointSimulations.CallOpts, op, target, targetCallData)
+}
+
+// SimulateValidation is a free data retrieval call binding the contract method 0xc3bce009.
+//
+// Solidity: function simulateValidation((address,uint256,bytes,bytes,bytes32,uint256,bytes32,bytes,bytes) userOp) view returns(((uint256,uint256,uint256,uint256,bytes),(uint256,uint256),(uint256,uint256),(uint256,uint256),(address,(uint256,uint256))))
+func (_EntryPointSimulations *EntryPointSimulationsCaller) SimulateValidation(opts *bind.CallOpts, userOp PackedUserOperation) (IEntryPointSimulationsValidationResult, error) {
+	var out []interface{}
+	err := _EntryPointSimulations.contract.Call(opts, &out, "simulateValidation", userOp)
+
+	if err != nil {
+		return *new(IEntryPointSimulationsValidationResult), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(IEntryPointSimulationsValidationResult)).(*IEntryPointSimulationsValidationResult)
+
+	return out0, err
+
+}
+
+// SimulateValidation is a free data retrieval call binding the contract method 0xc3bce009.
+//
+// Solidity: function simulateValidation((address,uint256,bytes,bytes,bytes32,uint256,bytes32,bytes,bytes) userOp) view returns(((uint256,uint256,uint256,uint256,bytes),(uint256,uint256),(uint256,uint256),(uint256,uint256),(address,(uint256,uint256))))
+func (_EntryPointSimulations *EntryPointSimulationsSession) SimulateValidation(userOp PackedUserOperation) (IEntryPointSimulationsValidationResult, error) {
+	return _EntryPointSimulations.Contract.SimulateValidation(&_EntryPointSimulations.CallOpts, userOp)
+}
+
+// SimulateValidation is a free data retrieval call binding the contract method 0xc3bce009.
+//
+// Solidity: function simulateValidation((address,uint256,bytes,bytes,bytes32,uint256,bytes32,bytes,bytes) userOp) view returns(((uint256,uint256,uint256,uint256,bytes),(uint256,uint256),(uint256,uint256),(uint256,uint256),(address,(uint256,uint256))))
+func (_EntryPointSimulations *EntryPointSimulationsCallerSession) SimulateValidation(userOp PackedUserOperation) (IEntryPointSimulationsValidationResult, error) {
+	return _EntryPointSimulations.Contract.SimulateValidation(&_EntryPointSimulations.CallOpts, userOp)
+}
+
+// EntryPointV06MetaData contains all meta data concerning the EntryPointV06 contract.
+var EntryPointV06MetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"balanceOf\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"function\",\"name\":\"depositTo\",\"stateMutability\":\"payable\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[]},{\"type\":\"function\",\"name\":\"getNonce\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"key\",\"type\":\"uint192\",\"internalType\":\"uint192\"}],\"outputs\":[{\"name\":\"nonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"function\",\"name\":\"getUserOpHash\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"userOp\",\"type\":\"tuple\",\"internalType\":\"structUserOperation\",\"components\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"nonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"initCode\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"callGasLimit\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"verificationGasLimit\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"preVerificationGas\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"maxFeePerGas\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"maxPriorityFeePerGas\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"paymasterAndData\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"signature\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]},{\"type\":\"function\",\"name\":\"handleOps\",\"stateMutability\":\"nonpayable\",\"inputs\":[{\"name\":\"ops\",\"type\":\"tuple[]\",\"internalType\":\"structUserOperation[]\",\"components\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"nonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"initCode\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"callGasLimit\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"verificationGasLimit\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"preVerificationGas\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"maxFeePerGas\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"maxPriorityFeePerGas\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"paymasterAndData\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"signature\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]},{\"name\":\"beneficiary\",\"type\":\"address\",\"internalType\":\"addresspayable\"}],\"outputs\":[]},{\"type\":\"function\",\"name\":\"simulateHandleOp\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"op\",\"type\":\"tuple\",\"internalType\":\"structUserOperation\",\"components\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"nonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"initCode\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"callGasLimit\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"verificationGasLimit\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"preVerificationGas\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"maxFeePerGas\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"maxPriorityFeePerGas\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"paymasterAndData\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"signature\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]},{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"targetCallData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[]},{\"type\":\"function\",\"name\":\"simulateValidation\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"userOp\",\"type\":\"tuple\",\"internalType\":\"structUserOperation\",\"components\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"nonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"initCode\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"callGasLimit\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"verificationGasLimit\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"preVerificationGas\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"maxFeePerGas\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"maxPriorityFeePerGas\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"paymasterAndData\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"signature\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"outputs\":[]}]",
+}
+
+// EntryPointV06ABI is the input ABI used to generate the binding from.
+// Deprecated: Use EntryPointV06MetaData.ABI instead.
+var EntryPointV06ABI = EntryPointV06MetaData.ABI
+
+// EntryPointV06 is an auto generated Go binding around an Ethereum contract.
+type EntryPointV06 struct {
+	EntryPointV06Caller     // Read-only binding to the contract
+	EntryPointV06Transactor // Write-only binding to the contract
+	EntryPointV06Filterer   // Log filterer for contract events
+}
+
+// EntryPointV06Caller is an auto generated read-only Go binding around an Ethereum contract.
+type EntryPointV06Caller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EntryPointV06Transactor is an auto generated write-only Go binding around an Ethereum contract.
+type EntryPointV06Transactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EntryPointV06Filterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type EntryPointV06Filterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EntryPointV06Session is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type EntryPointV06Session struct {
+	Contract     *EntryPointV06    // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// EntryPointV06CallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type EntryPointV06CallerSession struct {
+	Contract *EntryPointV06Caller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts        // Call options to use throughout this session
+}
+
+// EntryPointV06TransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type EntryPointV06TransactorSession struct {
+	Contract     *EntryPointV06Transactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts        // Transaction auth options to use throughout this session
+}
+
+// EntryPointV06Raw is an auto generated low-level Go binding around an Ethereum contract.
+type EntryPointV06Raw struct {
+	Contract *EntryPointV06 // Generic contract binding to access the raw methods on
+}
+
+// EntryPointV06CallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type EntryPointV06CallerRaw struct {
+	Contract *EntryPointV06Caller // Generic read-only contract binding to access the raw methods on
+}
+
+// EntryPointV06TransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type EntryPointV06TransactorRaw struct {
+	Contract *EntryPointV06Transactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewEntryPointV06 creates a new instance of EntryPointV06, bound to a specific deployed contract.
+func NewEntryPointV06(address common.Address, backend bind.ContractBackend) (*EntryPointV06, error) {
+	contract, err := bindEntryPointV06(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &EntryPointV06{EntryPointV06Caller: EntryPointV06Caller{contract: contract}, EntryPointV06Transactor: EntryPointV06Transactor{contract: contract}, EntryPointV06Filterer: EntryPointV06Filterer{contract: contract}}, nil
+}
+
+// NewEntryPointV06Caller creates a new read-only instance of EntryPointV06, bound to a specific deployed contract.
+func NewEntryPointV06Caller(address common.Address, caller bind.ContractCaller) (*EntryPointV06Caller, error) {
+	contract, err := bindEntryPointV06(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &EntryPointV06Caller{contract: contract}, nil
+}
+
+// NewEntryPointV06Transactor creates a new write-only instance of EntryPointV06, bound to a specific deployed contract.
+func NewEntryPointV06Transactor(address common.Address, transactor bind.ContractTransactor) (*EntryPointV06Transactor, error) {
+	contract, err := bindEntryPointV06(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &EntryPointV06Transactor{contract: contract}, nil
+}
+
+// NewEntryPointV06Filterer creates a new log filterer instance of EntryPointV06, bound to a specific deployed contract.
+func NewEntryPointV06Filterer(address common.Address, filterer bind.ContractFilterer) (*EntryPointV06Filterer, error) {
+	contract, err := bindEntryPointV06(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &EntryPointV06Filterer{contract: contract}, nil
+}
+
+// bindEntryPointV06 binds a generic wrapper to an already deployed contract.
+func bindEntryPointV06(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := EntryPointV06MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_EntryPointV06 *EntryPointV06Raw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _EntryPointV06.Contract.EntryPointV06Caller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_EntryPointV06 *EntryPointV06Raw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _EntryPointV06.Contract.EntryPointV06Transactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_EntryPointV06 *EntryPointV06Raw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _EntryPointV06.Contract.EntryPointV06Transactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_EntryPointV06 *EntryPointV06CallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _EntryPointV06.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_EntryPointV06 *EntryPointV06TransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _EntryPointV06.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_EntryPointV06 *EntryPointV06TransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _EntryPointV06.Contract.contract.Transact(opts, method, params...)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_EntryPointV06 *EntryPointV06Caller) BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _EntryPointV06.contract.Call(opts, &out, "balanceOf", account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_EntryPointV06 *EntryPointV06Session) BalanceOf(account common.Address) (*big.Int, error) {
+	return _EntryPointV06.Contract.BalanceOf(&_EntryPointV06.CallOpts, account)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_EntryPointV06 *EntryPointV06CallerSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _EntryPointV06.Contract.BalanceOf(&_EntryPointV06.CallOpts, account)
+}
+
+// GetNonce is a free data retrieval call binding the contract method 0x35567e1a.
+//
+// Solidity: function getNonce(address sender, uint192 key) view returns(uint256 nonce)
+func (_EntryPointV06 *EntryPointV06Caller) GetNonce(opts *bind.CallOpts, sender common.Address, key *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _EntryPointV06.contract.Call(opts, &out, "getNonce", sender, key)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetNonce is a free data retrieval call binding the contract method 0x35567e1a.
+//
+// Solidity: function getNonce(address sender, uint192 key) view returns(uint256 nonce)
+func (_EntryPointV06 *EntryPointV06Session) GetNonce(sender common.Address, key *big.Int) (*big.Int, error) {
+	return _EntryPointV06.Contract.GetNonce(&_EntryPointV06.CallOpts, sender, key)
+}
+
+// GetNonce is a free data retrieval call binding the contract method 0x35567e1a.
+//
+// Solidity: function getNonce(address sender, uint192 key) view returns(uint256 nonce)
+func (_EntryPointV06 *EntryPointV06CallerSession) GetNonce(sender common.Address, key *big.Int) (*big.Int, error) {
+	return _EntryPointV06.Contract.GetNonce(&_EntryPointV06.CallOpts, sender, key)
+}
+
+// GetUserOpHash is a free data retrieval call binding the contract method 0xa6193531.
+//
+// Solidity: function getUserOpHash((address,uint256,bytes,bytes,uint256,uint256,uint256,uint256,uint256,bytes,bytes) userOp) view returns(bytes32)
+func (_EntryPointV06 *EntryPointV06Caller) GetUserOpHash(opts *bind.CallOpts, userOp UserOperation) ([32]byte, error) {
+	var out []interface{}
+	err := _EntryPointV06.contract.Call(opts, &out, "getUserOpHash", userOp)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetUserOpHash is a free data retrieval call binding the contract method 0xa6193531.
+//
+// Solidity: function getUserOpHash((address,uint256,bytes,bytes,uint256,uint256,uint256,uint256,uint256,bytes,bytes) userOp) view returns(bytes32)
+func (_EntryPointV06 *EntryPointV06Session) GetUserOpHash(userOp UserOperation) ([32]byte, error) {
+	return _EntryPointV06.Contract.GetUserOpHash(&_EntryPointV06.CallOpts, userOp)
+}
+
+// GetUserOpHash is a free data retrieval call binding the contract method 0xa6193531.
+//
+// Solidity: function getUserOpHash((address,uint256,bytes,bytes,uint256,uint256,uint256,uint256,uint256,bytes,bytes) userOp) view returns(bytes32)
+func (_EntryPointV06 *EntryPointV06CallerSession) GetUserOpHash(userOp UserOperation) ([32]byte, error) {
+	return _EntryPointV06.Contract.GetUserOpHash(&_EntryPointV06.CallOpts, userOp)
+}
+
+// SimulateHandleOp is a free data retrieval call binding the contract method 0xd6383f94.
+//
+// Solidity: function simulateHandleOp((address,uint256,bytes,bytes,uint256,uint256,uint256,uint256,uint256,bytes,bytes) op, address target, bytes targetCallData) view returns()
+func (_EntryPointV06 *EntryPointV06Caller) SimulateHandleOp(opts *bind.CallOpts, op UserOperation, target common.Address, targetCallData []byte) error {
+	var out []interface{}
+	err := _EntryPointV06.contract.Call(opts, &out, "simulateHandleOp", op, target, targetCallData)
+
+	if err != nil {
+		return err
+	}
+
+	return err
+
+}
+
+// SimulateHandleOp is a free data retrieval call binding the contract method 0xd6383f94.
+//
+// Solidity: function simulateHandleOp((address,uint256,bytes,bytes,uint256,uint256,uint256,uint256,uint256,bytes,bytes) op, address target, bytes targetCallData) view returns()
+func (_EntryPointV06 *EntryPointV06Session) SimulateHandleOp(op UserOperation, target common.Address, targetCallData []byte) error {
+	return _EntryPointV06.Contract.SimulateHandleOp(&_EntryPointV06.CallOpts, op, target, targetCallData)
+}
+
+// SimulateHandleOp is a free data retrieval call binding the contract method 0xd6383f94.
+//
+// Solidity: function simulateHandleOp((address,uint256,bytes,bytes,uint256,uint256,uint256,uint256,uint256,bytes,bytes) op, address target, bytes targetCallData) view returns()
+func (_EntryPointV06 *EntryPointV06CallerSession) SimulateHandleOp(op UserOperation, target common.Address, targetCallData []byte) error {
+	return _EntryPointV06.Contract.SimulateHandleOp(&_EntryPointV06.CallOpts, op, target, targetCallData)
+}
+
+// SimulateValidation is a free data retrieval call binding the contract method 0xee219423.
+//
+// Solidity: function simulateValidation((address,uint256,bytes,bytes,uint256,uint256,uint256,uint256,uint256,bytes,bytes) userOp) view returns()
+func (_EntryPointV06 *EntryPointV06Caller) SimulateValidation(opts *bind.CallOpts, userOp UserOperation) error {
+	var out []interface{}
+	err := _EntryPointV06.contract.Call(opts, &out, "simulateValidation", userOp)
+
+	if err != nil {
+		return err
+	}
+
+	return err
+
+}
+
+// SimulateValidation is a free data retrieval call binding the contract method 0xee219423.
+//
+// Solidity: function simulateValidation((address,uint256,bytes,bytes,uint256,uint256,uint256,uint256,uint256,bytes,bytes) userOp) view returns()
+func (_EntryPointV06 *EntryPointV06Session) SimulateValidation(userOp UserOperation) error {
+	return _EntryPointV06.Contract.SimulateValidation(&_EntryPointV06.CallOpts, userOp)
+}
+
+// SimulateValidation is a free data retrieval call binding the contract method 0xee219423.
+//
+// Solidity: function simulateValidation((address,uint256,bytes,bytes,uint256,uint256,uint256,uint256,uint256,bytes,bytes) userOp) view returns()
+func (_EntryPointV06 *EntryPointV06CallerSession) SimulateValidation(userOp UserOperation) error {
+	return _EntryPointV06.Contract.SimulateValidation(&_EntryPointV06.CallOpts, userOp)
+}
+
+// DepositTo is a paid mutator transaction binding the contract method 0xb760faf9.
+//
+// Solidity: function depositTo(address account) payable returns()
+func (_EntryPointV06 *EntryPointV06Transactor) DepositTo(opts *bind.TransactOpts, account common.Address) (*types.Transaction, error) {
+	return _EntryPointV06.contract.Transact(opts, "depositTo", account)
+}
+
+// DepositTo is a paid mutator transaction binding the contract method 0xb760faf9.
+//
+// Solidity: function depositTo(address account) payable returns()
+func (_EntryPointV06 *EntryPointV06Session) DepositTo(account common.Address) (*types.Transaction, error) {
+	return _EntryPointV06.Contract.DepositTo(&_EntryPointV06.TransactOpts, account)
+}
+
+// DepositTo is a paid mutator transaction binding the contract method 0xb760faf9.
+//
+// Solidity: function depositTo(address account) payable returns()
+func (_EntryPointV06 *EntryPointV06TransactorSession) DepositTo(account common.Address) (*types.Transaction, error) {
+	return _EntryPointV06.Contract.DepositTo(&_EntryPointV06.TransactOpts, account)
+}
+
+// HandleOps is a paid mutator transaction binding the contract method 0x1fad948c.
+//
+// Solidity: function handleOps((address,uint256,bytes,bytes,uint256,uint256,uint256,uint256,uint256,bytes,bytes)[] ops, address beneficiary) returns()
+func (_EntryPointV06 *EntryPointV06Transactor) HandleOps(opts *bind.TransactOpts, ops []UserOperation, beneficiary common.Address) (*types.Transaction, error) {
+	return _EntryPointV06.contract.Transact(opts, "handleOps", ops, beneficiary)
+}
+
+// HandleOps is a paid mutator transaction binding the contract method 0x1fad948c.
+//
+// Solidity: function handleOps((address,uint256,bytes,bytes,uint256,uint256,uint256,uint256,uint256,bytes,bytes)[] ops, address beneficiary) returns()
+func (_EntryPointV06 *EntryPointV06Session) HandleOps(ops []UserOperation, beneficiary common.Address) (*types.Transaction, error) {
+	return _EntryPointV06.Contract.HandleOps(&_EntryPointV06.TransactOpts, ops, beneficiary)
+}
+
+// HandleOps is a paid mutator transaction binding the contract method 0x1fad948c.
+//
+// Solidity: function handleOps((address,uint256,bytes,bytes,uint256,uint256,uint256,uint256,uint256,bytes,bytes)[] ops, address beneficiary) returns()
+func (_EntryPointV06 *EntryPointV06TransactorSession) HandleOps(ops []UserOperation, beneficiary common.Address) (*types.Transaction, error) {
+	return _EntryPointV06.Contract.HandleOps(&_EntryPointV06.TransactOpts, ops, beneficiary)
+}
+
+// EntryPointV07MetaData contains all meta data concerning the EntryPointV07 contract.
+var EntryPointV07MetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"balanceOf\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"function\",\"name\":\"depositTo\",\"stateMutability\":\"payable\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[]},{\"type\":\"function\",\"name\":\"getNonce\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"key\",\"type\":\"uint192\",\"internalType\":\"uint192\"}],\"outputs\":[{\"name\":\"nonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"function\",\"name\":\"getUserOpHash\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"userOp\",\"type\":\"tuple\",\"internalType\":\"structPackedUserOperation\",\"components\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"nonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"initCode\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"accountGasLimits\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"preVerificationGas\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"gasFees\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"paymasterAndData\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"signature\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]},{\"type\":\"function\",\"name\":\"handleOps\",\"stateMutability\":\"nonpayable\",\"inputs\":[{\"name\":\"ops\",\"type\":\"tuple[]\",\"internalType\":\"structPackedUserOperation[]\",\"components\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"nonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"initCode\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"accountGasLimits\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"preVerificationGas\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"gasFees\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"paymasterAndData\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"signature\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]},{\"name\":\"beneficiary\",\"type\":\"address\",\"internalType\":\"addresspayable\"}],\"outputs\":[]}]",
+}
+
+// EntryPointV07ABI is the input ABI used to generate the binding from.
+// Deprecated: Use EntryPointV07MetaData.ABI instead.
+var EntryPointV07ABI = EntryPointV07MetaData.ABI
+
+// EntryPointV07 is an auto generated Go binding around an Ethereum contract.
+type EntryPointV07 struct {
+	EntryPointV07Caller     // Read-only binding to the contract
+	EntryPointV07Transactor // Write-only binding to the contract
+	EntryPointV07Filterer   // Log filterer for contract events
+}
+
+// EntryPointV07Caller is an auto generated read-only Go binding around an Ethereum contract.
+type EntryPointV07Caller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EntryPointV07Transactor is an auto generated write-only Go binding around an Ethereum contract.
+type EntryPointV07Transactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EntryPointV07Filterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type EntryPointV07Filterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EntryPointV07Session is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type EntryPointV07Session struct {
+	Contract     *EntryPointV07    // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// EntryPointV07CallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type EntryPointV07CallerSession struct {
+	Contract *EntryPointV07Caller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts        // Call options to use throughout this session
+}
+
+// EntryPointV07TransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type EntryPointV07TransactorSession struct {
+	Contract     *EntryPointV07Transactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts        // Transaction auth options to use throughout this session
+}
+
+// EntryPointV07Raw is an auto generated low-level Go binding around an Ethereum contract.
+type EntryPointV07Raw struct {
+	Contract *EntryPointV07 // Generic contract binding to access the raw methods on
+}
+
+// EntryPointV07CallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type EntryPointV07CallerRaw struct {
+	Contract *EntryPointV07Caller // Generic read-only contract binding to access the raw methods on
+}
+
+// EntryPointV07TransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type EntryPointV07TransactorRaw struct {
+	Contract *EntryPointV07Transactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewEntryPointV07 creates a new instance of EntryPointV07, bound to a specific deployed contract.
+func NewEntryPointV07(address common.Address, backend bind.ContractBackend) (*EntryPointV07, error) {
+	contract, err := bindEntryPointV07(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &EntryPointV07{EntryPointV07Caller: EntryPointV07Caller{contract: contract}, EntryPointV07Transactor: EntryPointV07Transactor{contract: contract}, EntryPointV07Filterer: EntryPointV07Filterer{contract: contract}}, nil
+}
+
+// NewEntryPointV07Caller creates a new read-only instance of EntryPointV07, bound to a specific deployed contract.
+func NewEntryPointV07Caller(address common.Address, caller bind.ContractCaller) (*EntryPointV07Caller, error) {
+	contract, err := bindEntryPointV07(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &EntryPointV07Caller{contract: contract}, nil
+}
+
+// NewEntryPointV07Transactor creates a new write-only instance of EntryPointV07, bound to a specific deployed contract.
+func NewEntryPointV07Transactor(address common.Address, transactor bind.ContractTransactor) (*EntryPointV07Transactor, error) {
+	contract, err := bindEntryPointV07(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &EntryPointV07Transactor{contract: contract}, nil
+}
+
+// NewEntryPointV07Filterer creates a new log filterer instance of EntryPointV07, bound to a specific deployed contract.
+func NewEntryPointV07Filterer(address common.Address, filterer bind.ContractFilterer) (*EntryPointV07Filterer, error) {
+	contract, err := bindEntryPointV07(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &EntryPointV07Filterer{contract: contract}, nil
+}
+
+// bindEntryPointV07 binds a generic wrapper to an already deployed contract.
+func bindEntryPointV07(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := EntryPointV07MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_EntryPointV07 *EntryPointV07Raw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _EntryPointV07.Contract.EntryPointV07Caller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_EntryPointV07 *EntryPointV07Raw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _EntryPointV07.Contract.EntryPointV07Transactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_EntryPointV07 *EntryPointV07Raw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _EntryPointV07.Contract.EntryPointV07Transactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_EntryPointV07 *EntryPointV07CallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _EntryPointV07.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_EntryPointV07 *EntryPointV07TransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _EntryPointV07.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_EntryPointV07 *EntryPointV07TransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _EntryPointV07.Contract.contract.Transact(opts, method, params...)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_EntryPointV07 *EntryPointV07Caller) BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _EntryPointV07.contract.Call(opts, &out, "balanceOf", account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_EntryPointV07 *EntryPointV07Session) BalanceOf(account common.Address) (*big.Int, error) {
+	return _EntryPointV07.Contract.BalanceOf(&_EntryPointV07.CallOpts, account)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_EntryPointV07 *EntryPointV07CallerSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _EntryPointV07.Contract.BalanceOf(&_EntryPointV07.CallOpts, account)
+}
+
+// GetNonce is a free data retrieval call binding the contract method 0x35567e1a.
+//
+// Solidity: function getNonce(address sender, uint192 key) view returns(uint256 nonce)
+func (_EntryPointV07 *EntryPointV07Caller) GetNonce(opts *bind.CallOpts, sender common.Address, key *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _EntryPointV07.contract.Call(opts, &out, "getNonce", sender, key)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetNonce is a free data retrieval call binding the contract method 0x35567e1a.
+//
+// Solidity: function getNonce(address sender, uint192 key) view returns(uint256 nonce)
+func (_EntryPointV07 *EntryPointV07Session) GetNonce(sender common.Address, key *big.Int) (*big.Int, error) {
+	return _EntryPointV07.Contract.GetNonce(&_EntryPointV07.CallOpts, sender, key)
+}
+
+// GetNonce is a free data retrieval call binding the contract method 0x35567e1a.
+//
+// Solidity: function getNonce(address sender, uint192 key) view returns(uint256 nonce)
+func (_EntryPointV07 *EntryPointV07CallerSession) GetNonce(sender common.Address, key *big.Int) (*big.Int, error) {
+	return _EntryPointV07.Contract.GetNonce(&_EntryPointV07.CallOpts, sender, key)
+}
+
+// GetUserOpHash is a free data retrieval call binding the contract method 0x22cdde4c.
+//
+// Solidity: function getUserOpHash((address,uint256,bytes,bytes,bytes32,uint256,bytes32,bytes,bytes) userOp) view returns(bytes32)
+func (_EntryPointV07 *EntryPointV07Caller) GetUserOpHash(opts *bind.CallOpts, userOp PackedUserOperation) ([32]byte, error) {
+	var out []interface{}
+	err := _EntryPointV07.contract.Call(opts, &out, "getUserOpHash", userOp)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetUserOpHash is a free data retrieval call binding the contract method 0x22cdde4c.
+//
+// Solidity: function getUserOpHash((address,uint256,bytes,bytes,bytes32,uint256,bytes32,bytes,bytes) userOp) view returns(bytes32)
+func (_EntryPointV07 *EntryPointV07Session) GetUserOpHash(userOp PackedUserOperation) ([32]byte, error) {
+	return _EntryPointV07.Contract.GetUserOpHash(&_EntryPointV07.CallOpts, userOp)
+}
+
+// GetUserOpHash is a free data retrieval call binding the contract method 0x22cdde4c.
+//
+// Solidity: function getUserOpHash((address,uint256,bytes,bytes,bytes32,uint256,bytes32,bytes,bytes) userOp) view returns(bytes32)
+func (_EntryPointV07 *EntryPointV07CallerSession) GetUserOpHash(userOp PackedUserOperation) ([32]byte, error) {
+	return _EntryPointV07.Contract.GetUserOpHash(&_EntryPointV07.CallOpts, userOp)
+}
+
+// DepositTo is a paid mutator transaction binding the contract method 0xb760faf9.
+//
+// Solidity: function depositTo(address account) payable returns()
+func (_EntryPointV07 *EntryPointV07Transactor) DepositTo(opts *bind.TransactOpts, account common.Address) (*types.Transaction, error) {
+	return _EntryPointV07.contract.Transact(opts, "depositTo", account)
+}
+
+// DepositTo is a paid mutator transaction binding the contract method 0xb760faf9.
+//
+// Solidity: function depositTo(address account) payable returns()
+func (_EntryPointV07 *EntryPointV07Session) DepositTo(account common.Address) (*types.Transaction, error) {
+	return _EntryPointV07.Contract.DepositTo(&_EntryPointV07.TransactOpts, account)
+}
+
+// DepositTo is a paid mutator transaction binding the contract method 0xb760faf9.
+//
+// Solidity: function depositTo(address account) payable returns()
+func (_EntryPointV07 *EntryPointV07TransactorSession) DepositTo(account common.Address) (*types.Transaction, error) {
+	return _EntryPointV07.Contract.DepositTo(&_EntryPointV07.TransactOpts, account)
+}
+
+// HandleOps is a paid mutator transaction binding the contract method 0x765e827f.
+//
+// Solidity: function handleOps((address,uint256,bytes,bytes,bytes32,uint256,bytes32,bytes,bytes)[] ops, address beneficiary) returns()
+func (_EntryPointV07 *EntryPointV07Transactor) HandleOps(opts *bind.TransactOpts, ops []PackedUserOperation, beneficiary common.Address) (*types.Transaction, error) {
+	return _EntryPointV07.contract.Transact(opts, "handleOps", ops, beneficiary)
+}
+
+// HandleOps is a paid mutator transaction binding the contract method 0x765e827f.
+//
+// Solidity: function handleOps((address,uint256,bytes,bytes,bytes32,uint256,bytes32,bytes,bytes)[] ops, address beneficiary) returns()
+func (_EntryPointV07 *EntryPointV07Session) HandleOps(ops []PackedUserOperation, beneficiary common.Address) (*types.Transaction, error) {
+	return _EntryPointV07.Contract.HandleOps(&_EntryPointV07.TransactOpts, ops, beneficiary)
+}
+
+// HandleOps is a paid mutator transaction binding the contract method 0x765e827f.
+//
+// Solidity: function handleOps((address,uint256,bytes,bytes,bytes32,uint256,bytes32,bytes,bytes)[] ops, address beneficiary) returns()
+func (_EntryPointV07 *EntryPointV07TransactorSession) HandleOps(ops []PackedUserOperation, beneficiary common.Address) (*types.Transaction, error) {
+	return _EntryPointV07.Contract.HandleOps(&_EntryPointV07.TransactOpts, ops, beneficiary)
+}
+
+// EntryPointV08MetaData contains all meta data concerning the EntryPointV08 contract.
+var EntryPointV08MetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"balanceOf\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"function\",\"name\":\"depositTo\",\"stateMutability\":\"payable\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[]},{\"type\":\"function\",\"name\":\"getNonce\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"key\",\"type\":\"uint192\",\"internalType\":\"uint192\"}],\"outputs\":[{\"name\":\"nonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"function\",\"name\":\"getUserOpHash\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"userOp\",\"type\":\"tuple\",\"internalType\":\"structPackedUserOperation\",\"components\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"nonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"initCode\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"accountGasLimits\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"preVerificationGas\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"gasFees\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"paymasterAndData\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"signature\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]},{\"type\":\"function\",\"name\":\"handleOps\",\"stateMutability\":\"nonpayable\",\"inputs\":[{\"name\":\"ops\",\"type\":\"tuple[]\",\"internalType\":\"structPackedUserOperation[]\",\"components\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"nonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"initCode\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"accountGasLimits\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"preVerificationGas\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"gasFees\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"paymasterAndData\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"signature\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]},{\"name\":\"beneficiary\",\"type\":\"address\",\"internalType\":\"addresspayable\"}],\"outputs\":[]}]",
+}
+
+// EntryPointV08ABI is the input ABI used to generate the binding from.
+// Deprecated: Use EntryPointV08MetaData.ABI instead.
+var EntryPointV08ABI = EntryPointV08MetaData.ABI
+
+// EntryPointV08 is an auto generated Go binding around an Ethereum contract.
+type EntryPointV08 struct {
+	EntryPointV08Caller     // Read-only binding to the contract
+	EntryPointV08Transactor // Write-only binding to the contract
+	EntryPointV08Filterer   // Log filterer for contract events
+}
+
+// EntryPointV08Caller is an auto generated read-only Go binding around an Ethereum contract.
+type EntryPointV08Caller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EntryPointV08Transactor is an auto generated write-only Go binding around an Ethereum contract.
+type EntryPointV08Transactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EntryPointV08Filterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type EntryPointV08Filterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EntryPointV08Session is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type EntryPointV08Session struct {
+	Contract     *EntryPointV08    // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// EntryPointV08CallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type EntryPointV08CallerSession struct {
+	Contract *EntryPointV08Caller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts        // Call options to use throughout this session
+}
+
+// EntryPointV08TransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type EntryPointV08TransactorSession struct {
+	Contract     *EntryPointV08Transactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts        // Transaction auth options to use throughout this session
+}
+
+// EntryPointV08Raw is an auto generated low-level Go binding around an Ethereum contract.
+type EntryPointV08Raw struct {
+	Contract *EntryPointV08 // Generic contract binding to access the raw methods on
+}
+
+// EntryPointV08CallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type EntryPointV08CallerRaw struct {
+	Contract *EntryPointV08Caller // Generic read-only contract binding to access the raw methods on
+}
+
+// EntryPointV08TransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type EntryPointV08TransactorRaw struct {
+	Contract *EntryPointV08Transactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewEntryPointV08 creates a new instance of EntryPointV08, bound to a specific deployed contract.
+func NewEntryPointV08(address common.Address, backend bind.ContractBackend) (*EntryPointV08, error) {
+	contract, err := bindEntryPointV08(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &EntryPointV08{EntryPointV08Caller: EntryPointV08Caller{contract: contract}, EntryPointV08Transactor: EntryPointV08Transactor{contract: contract}, EntryPointV08Filterer: EntryPointV08Filterer{contract: contract}}, nil
+}
+
+// NewEntryPointV08Caller creates a new read-only instance of EntryPointV08, bound to a specific deployed contract.
+func NewEntryPointV08Caller(address common.Address, caller bind.ContractCaller) (*EntryPointV08Caller, error) {
+	contract, err := bindEntryPointV08(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &EntryPointV08Caller{contract: contract}, nil
+}
+
+// NewEntryPointV08Transactor creates a new write-only instance of EntryPointV08, bound to a specific deployed contract.
+func NewEntryPointV08Transactor(address common.Address, transactor bind.ContractTransactor) (*EntryPointV08Transactor, error) {
+	contract, err := bindEntryPointV08(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &EntryPointV08Transactor{contract: contract}, nil
+}
+
+// NewEntryPointV08Filterer creates a new log filterer instance of EntryPointV08, bound to a specific deployed contract.
+func NewEntryPointV08Filterer(address common.Address, filterer bind.ContractFilterer) (*EntryPointV08Filterer, error) {
+	contract, err := bindEntryPointV08(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &EntryPointV08Filterer{contract: contract}, nil
+}
+
+// bindEntryPointV08 binds a generic wrapper to an already deployed contract.
+func bindEntryPointV08(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := EntryPointV08MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_EntryPointV08 *EntryPointV08Raw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _EntryPointV08.Contract.EntryPointV08Caller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_EntryPointV08 *EntryPointV08Raw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _EntryPointV08.Contract.EntryPointV08Transactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_EntryPointV08 *EntryPointV08Raw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _EntryPointV08.Contract.EntryPointV08Transactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_EntryPointV08 *EntryPointV08CallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _EntryPointV08.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_EntryPointV08 *EntryPointV08TransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _EntryPointV08.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_EntryPointV08 *EntryPointV08TransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _EntryPointV08.Contract.contract.Transact(opts, method, params...)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_EntryPointV08 *EntryPointV08Caller) BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _EntryPointV08.contract.Call(opts, &out, "balanceOf", account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_EntryPointV08 *EntryPointV08Session) BalanceOf(account common.Address) (*big.Int, error) {
+	return _EntryPointV08.Contract.BalanceOf(&_EntryPointV08.CallOpts, account)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_EntryPointV08 *EntryPointV08CallerSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _EntryPointV08.Contract.BalanceOf(&_EntryPointV08.CallOpts, account)
+}
+
+// GetNonce is a free data retrieval call binding the contract method 0x35567e1a.
+//
+// Solidity: function getNonce(address sender, uint192 key) view returns(uint256 nonce)
+func (_EntryPointV08 *EntryPointV08Caller) GetNonce(opts *bind.CallOpts, sender common.Address, key *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _EntryPointV08.contract.Call(opts, &out, "getNonce", sender, key)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetNonce is a free data retrieval call binding the contract method 0x35567e1a.
+//
+// Solidity: function getNonce(address sender, uint192 key) view returns(uint256 nonce)
+func (_EntryPointV08 *EntryPointV08Session) GetNonce(sender common.Address, key *big.Int) (*big.Int, error) {
+	return _EntryPointV08.Contract.GetNonce(&_EntryPointV08.CallOpts, sender, key)
+}
+
+// GetNonce is a free data retrieval call binding the contract method 0x35567e1a.
+//
+// Solidity: function getNonce(address sender, uint192 key) view returns(uint256 nonce)
+func (_EntryPointV08 *EntryPointV08CallerSession) GetNonce(sender common.Address, key *big.Int) (*big.Int, error) {
+	return _EntryPointV08.Contract.GetNonce(&_EntryPointV08.CallOpts, sender, key)
+}
+
+// GetUserOpHash is a free data retrieval call binding the contract method 0x22cdde4c.
+//
+// Solidity: function getUserOpHash((address,uint256,bytes,bytes,bytes32,uint256,bytes32,bytes,bytes) userOp) view returns(bytes32)
+func (_EntryPointV08 *EntryPointV08Caller) GetUserOpHash(opts *bind.CallOpts, userOp PackedUserOperation) ([32]byte, error) {
+	var out []interface{}
+	err := _EntryPointV08.contract.Call(opts, &out, "getUserOpHash", userOp)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetUserOpHash is a free data retrieval call binding the contract method 0x22cdde4c.
+//
+// Solidity: function getUserOpHash((address,uint256,bytes,bytes,bytes32,uint256,bytes32,bytes,bytes) userOp) view returns(bytes32)
+func (_EntryPointV08 *EntryPointV08Session) GetUserOpHash(userOp PackedUserOperation) ([32]byte, error) {
+	return _EntryPointV08.Contract.GetUserOpHash(&_EntryPointV08.CallOpts, userOp)
+}
+
+// GetUserOpHash is a free data retrieval call binding the contract method 0x22cdde4c.
+//
+// Solidity: function getUserOpHash((address,uint256,bytes,bytes,bytes32,uint256,bytes32,bytes,bytes) userOp) view returns(bytes32)
+func (_EntryPointV08 *EntryPointV08CallerSession) GetUserOpHash(userOp PackedUserOperation) ([32]byte, error) {
+	return _EntryPointV08.Contract.GetUserOpHash(&_EntryPointV08.CallOpts, userOp)
+}
+
+// DepositTo is a paid mutator transaction binding the contract method 0xb760faf9.
+//
+// Solidity: function depositTo(address account) payable returns()
+func (_EntryPointV08 *EntryPointV08Transactor) DepositTo(opts *bind.TransactOpts, account common.Address) (*types.Transaction, error) {
+	return _EntryPointV08.contract.Transact(opts, "depositTo", account)
+}
+
+// DepositTo is a paid mutator transaction binding the contract method 0xb760faf9.
+//
+// Solidity: function depositTo(address account) payable returns()
+func (_EntryPointV08 *EntryPointV08Session) DepositTo(account common.Address) (*types.Transaction, error) {
+	return _EntryPointV08.Contract.DepositTo(&_EntryPointV08.TransactOpts, account)
+}
+
+// DepositTo is a paid mutator transaction binding the contract method 0xb760faf9.
+//
+// Solidity: function depositTo(address account) payable returns()
+func (_EntryPointV08 *EntryPointV08TransactorSession) DepositTo(account common.Address) (*types.Transaction, error) {
+	return _EntryPointV08.Contract.DepositTo(&_EntryPointV08.TransactOpts, account)
+}
+
+// HandleOps is a paid mutator transaction binding the contract method 0x765e827f.
+//
+// Solidity: function handleOps((address,uint256,bytes,bytes,bytes32,uint256,bytes32,bytes,bytes)[] ops, address beneficiary) returns()
+func (_EntryPointV08 *EntryPointV08Transactor) HandleOps(opts *bind.TransactOpts, ops []PackedUserOperation, beneficiary common.Address) (*types.Transaction, error) {
+	return _EntryPointV08.contract.Transact(opts, "handleOps", ops, beneficiary)
+}
+
+// HandleOps is a paid mutator transaction binding the contract method 0x765e827f.
+//
+// Solidity: function handleOps((address,uint256,bytes,bytes,bytes32,uint256,bytes32,bytes,bytes)[] ops, address beneficiary) returns()
+func (_EntryPointV08 *EntryPointV08Session) HandleOps(ops []PackedUserOperation, beneficiary common.Address) (*types.Transaction, error) {
+	return _EntryPointV08.Contract.HandleOps(&_EntryPointV08.TransactOpts, ops, beneficiary)
+}
+
+// HandleOps is a paid mutator transaction binding the contract method 0x765e827f.
+//
+// Solidity: function handleOps((address,uint256,bytes,bytes,bytes32,uint256,bytes32,bytes,bytes)[] ops, address beneficiary) returns()
+func (_EntryPointV08 *EntryPointV08TransactorSession) HandleOps(ops []PackedUserOperation, beneficiary common.Address) (*types.Transaction, error) {
+	return _EntryPointV08.Contract.HandleOps(&_EntryPointV08.TransactOpts, ops, beneficiary)
+}
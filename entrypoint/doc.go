@@ -0,0 +1,13 @@
+// Package entrypoint provides abigen bindings for the parts of the v0.6, v0.7
+// and v0.8 EntryPoint contracts used alongside the client: getNonce,
+// balanceOf, depositTo, getUserOpHash, handleOps and the simulation methods.
+//
+// The bindings were generated from trimmed copies of the eth-infinitism
+// ABIs, with simulateValidation and simulateHandleOp marked view so that
+// they are bound as calls. On v0.6 they always revert, and the revert data
+// carried by the returned error is decoded with the root package's
+// DecodeFailedOp and Simulator. From v0.7 they live in EntryPointSimulations
+// instead, which is not deployed: bind it to the EntryPoint address and call
+// it with the simulations contract's code set as a state override. The v0.7
+// simulations ABI also serves v0.8.
+package entrypoint
@@ -0,0 +1,164 @@
+package bundler_client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// ERC6492MagicSuffix terminates ERC-6492 wrapped signatures.
+var ERC6492MagicSuffix = common.FromHex("0x6492649264926492649264926492649264926492649264926492649264926492")
+
+// erc1271MagicValue is returned by isValidSignature for valid signatures.
+var erc1271MagicValue = [4]byte{0x16, 0x26, 0xba, 0x7e}
+
+const erc6492ABI = `[
+	{"type":"function","name":"isValidSignature","stateMutability":"view","inputs":[{"name":"hash","type":"bytes32"},{"name":"signature","type":"bytes"}],"outputs":[{"name":"","type":"bytes4"}]},
+	{"type":"function","name":"wrapped","inputs":[{"name":"factory","type":"address"},{"name":"factoryCalldata","type":"bytes"},{"name":"signature","type":"bytes"}]},
+	{"type":"function","name":"validateSigOffchain","inputs":[{"name":"signer","type":"address"},{"name":"hash","type":"bytes32"},{"name":"signature","type":"bytes"}]}
+]`
+
+var erc6492, _ = abi.JSON(strings.NewReader(erc6492ABI))
+
+var ErrValidatorCodeRequired = errors.New("validating a wrapped signature of an undeployed account requires validator code")
+
+// ERC6492Signature is an unwrapped ERC-6492 signature: the signature of a
+// not-yet-deployed account, with the factory call that deploys it.
+type ERC6492Signature struct {
+	Factory         common.Address
+	FactoryCalldata []byte
+	Signature       []byte
+}
+
+// ParseERC6492Signature unwraps sig. It returns false if sig does not carry
+// the ERC-6492 magic suffix.
+func ParseERC6492Signature(sig []byte) (*ERC6492Signature, bool, error) {
+	if !bytes.HasSuffix(sig, ERC6492MagicSuffix) {
+		return nil, false, nil
+	}
+	values, err := erc6492.Methods["wrapped"].Inputs.Unpack(sig[:len(sig)-len(ERC6492MagicSuffix)])
+	if err != nil {
+		return nil, true, err
+	}
+	return &ERC6492Signature{
+		Factory:         values[0].(common.Address),
+		FactoryCalldata: values[1].([]byte),
+		Signature:       values[2].([]byte),
+	}, true, nil
+}
+
+// Wrap returns the ERC-6492 encoding of s.
+func (s *ERC6492Signature) Wrap() ([]byte, error) {
+	packed, err := erc6492.Methods["wrapped"].Inputs.Pack(s.Factory, s.FactoryCalldata, s.Signature)
+	if err != nil {
+		return nil, err
+	}
+	return append(packed, ERC6492MagicSuffix...), nil
+}
+
+// SignatureValidator validates signatures of EOAs, deployed smart accounts
+// (ERC-1271) and counterfactual smart accounts (ERC-6492), e.g. to check the
+// signature of a first-time userop before sending it.
+type SignatureValidator struct {
+	Backend bind.ContractCaller
+	// ValidatorCode is the creation bytecode of the ValidateSigOffchain
+	// contract from the ERC-6492 reference implementation. It is eth_call-ed
+	// without deployment to deploy the account and verify the signature
+	// atomically, and is required only for wrapped signatures of undeployed
+	// accounts.
+	ValidatorCode []byte
+}
+
+func NewSignatureValidator(backend bind.ContractCaller, validatorCode []byte) *SignatureValidator {
+	return &SignatureValidator{Backend: backend, ValidatorCode: validatorCode}
+}
+
+// IsValidSignature reports whether sig is a valid signature of hash by
+// signer. A signature rejected by the account, including by reverting, is
+// reported as invalid rather than as an error.
+func (v *SignatureValidator) IsValidSignature(ctx context.Context, signer common.Address, hash common.Hash, sig []byte) (bool, error) {
+	if v.ValidatorCode != nil {
+		return v.validateOffchain(ctx, signer, hash, sig)
+	}
+	wrapped, isWrapped, err := ParseERC6492Signature(sig)
+	if err != nil {
+		return false, err
+	}
+	code, err := v.Backend.CodeAt(ctx, signer, nil)
+	if err != nil {
+		return false, err
+	}
+	if len(code) == 0 {
+		if isWrapped {
+			return false, ErrValidatorCodeRequired
+		}
+		return recoversTo(signer, hash, sig), nil
+	}
+	if isWrapped {
+		sig = wrapped.Signature
+	}
+	input, err := erc6492.Pack("isValidSignature", hash, sig)
+	if err != nil {
+		return false, err
+	}
+	res, err := v.Backend.CallContract(ctx, ethereum.CallMsg{To: &signer, Data: input}, nil)
+	if err != nil {
+		if isRevert(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return len(res) >= 4 && bytes.Equal(res[:4], erc1271MagicValue[:]), nil
+}
+
+// validateOffchain eth_calls the ValidateSigOffchain constructor, which
+// returns a single byte: 1 if the signature is valid. It reverts when the
+// account's deployment or ERC-1271 check reverts, which is an invalid
+// signature too.
+func (v *SignatureValidator) validateOffchain(ctx context.Context, signer common.Address, hash common.Hash, sig []byte) (bool, error) {
+	args, err := erc6492.Methods["validateSigOffchain"].Inputs.Pack(signer, hash, sig)
+	if err != nil {
+		return false, err
+	}
+	data := append(append([]byte{}, v.ValidatorCode...), args...)
+	res, err := v.Backend.CallContract(ctx, ethereum.CallMsg{Data: data}, nil)
+	if err != nil {
+		if isRevert(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return len(res) == 1 && res[0] == 1, nil
+}
+
+// recoversTo reports whether sig is a 65 byte ECDSA signature of hash by
+// signer, accepting v as 0/1 or 27/28.
+func recoversTo(signer common.Address, hash common.Hash, sig []byte) bool {
+	if len(sig) != crypto.SignatureLength {
+		return false
+	}
+	sig = common.CopyBytes(sig)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	pub, err := crypto.SigToPub(hash[:], sig)
+	if err != nil {
+		return false
+	}
+	return crypto.PubkeyToAddress(*pub) == signer
+}
+
+// isRevert reports whether err is an eth_call execution revert rather than a
+// transport or node failure.
+func isRevert(err error) bool {
+	var dataErr rpc.DataError
+	return errors.As(err, &dataErr) || strings.Contains(err.Error(), "execution reverted")
+}
@@ -0,0 +1,51 @@
+package bundler_client
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// MissingFieldError is returned when a bundler response leaves out (or
+// returns null for) fields that are required to build a valid user operation.
+type MissingFieldError struct {
+	Fields []string
+}
+
+func (e *MissingFieldError) Error() string {
+	return fmt.Sprintf("bundler response missing fields: %s", strings.Join(e.Fields, ", "))
+}
+
+// MissingEstimateFields returns the names of the gas estimate fields that the
+// bundler omitted or returned as null. The legacy verificationGas field is
+// accepted in place of verificationGasLimit.
+func MissingEstimateFields(e *GasEstimates) []string {
+	var missing []string
+	if e.PreVerificationGas == nil {
+		missing = append(missing, "preVerificationGas")
+	}
+	if e.VerificationGasLimit == nil && e.VerificationGas == nil {
+		missing = append(missing, "verificationGasLimit")
+	}
+	if e.CallGasLimit == nil {
+		missing = append(missing, "callGasLimit")
+	}
+	return missing
+}
+
+// ApplyGasEstimates copies the estimated gas values into op. If any estimate
+// field is missing, op is left untouched and a *MissingFieldError is returned,
+// rather than silently producing an op with zero gas limits.
+func ApplyGasEstimates(op *UserOperation, e *GasEstimates) error {
+	if missing := MissingEstimateFields(e); len(missing) > 0 {
+		return &MissingFieldError{Fields: missing}
+	}
+	vgl := e.VerificationGasLimit
+	if vgl == nil {
+		vgl = e.VerificationGas
+	}
+	op.PreVerificationGas = new(big.Int).Set(e.PreVerificationGas)
+	op.VerificationGasLimit = new(big.Int).Set(vgl)
+	op.CallGasLimit = new(big.Int).Set(e.CallGasLimit)
+	return nil
+}
@@ -0,0 +1,171 @@
+package bundler_client
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// entryPointEventsABI covers the events emitted identically by the v0.6 and
+// v0.7 EntryPoints, and PostOpRevertReason, which was added in v0.7.
+const entryPointEventsABI = `[
+	{"type":"event","name":"UserOperationEvent","inputs":[{"name":"userOpHash","type":"bytes32","indexed":true},{"name":"sender","type":"address","indexed":true},{"name":"paymaster","type":"address","indexed":true},{"name":"nonce","type":"uint256","indexed":false},{"name":"success","type":"bool","indexed":false},{"name":"actualGasCost","type":"uint256","indexed":false},{"name":"actualGasUsed","type":"uint256","indexed":false}]},
+	{"type":"event","name":"UserOperationRevertReason","inputs":[{"name":"userOpHash","type":"bytes32","indexed":true},{"name":"sender","type":"address","indexed":true},{"name":"nonce","type":"uint256","indexed":false},{"name":"revertReason","type":"bytes","indexed":false}]},
+	{"type":"event","name":"AccountDeployed","inputs":[{"name":"userOpHash","type":"bytes32","indexed":true},{"name":"sender","type":"address","indexed":true},{"name":"factory","type":"address","indexed":false},{"name":"paymaster","type":"address","indexed":false}]},
+	{"type":"event","name":"PostOpRevertReason","inputs":[{"name":"userOpHash","type":"bytes32","indexed":true},{"name":"sender","type":"address","indexed":true},{"name":"nonce","type":"uint256","indexed":false},{"name":"revertReason","type":"bytes","indexed":false}]}
+]`
+
+var entryPointEvents, _ = abi.JSON(strings.NewReader(entryPointEventsABI))
+
+var (
+	UserOperationEventTopic        = entryPointEvents.Events["UserOperationEvent"].ID
+	UserOperationRevertReasonTopic = entryPointEvents.Events["UserOperationRevertReason"].ID
+	AccountDeployedTopic           = entryPointEvents.Events["AccountDeployed"].ID
+	PostOpRevertReasonTopic        = entryPointEvents.Events["PostOpRevertReason"].ID
+)
+
+type UserOperationEvent struct {
+	UserOpHash    common.Hash
+	Sender        common.Address
+	Paymaster     common.Address
+	Nonce         *big.Int
+	Success       bool
+	ActualGasCost *big.Int
+	ActualGasUsed *big.Int
+	Raw           *types.Log
+}
+
+type UserOperationRevertReason struct {
+	UserOpHash   common.Hash
+	Sender       common.Address
+	Nonce        *big.Int
+	RevertReason []byte
+	Raw          *types.Log
+}
+
+type AccountDeployed struct {
+	UserOpHash common.Hash
+	Sender     common.Address
+	Factory    common.Address
+	Paymaster  common.Address
+	Raw        *types.Log
+}
+
+// PostOpRevertReason is emitted by v0.7 EntryPoints when a paymaster's
+// postOp reverts.
+type PostOpRevertReason struct {
+	UserOpHash   common.Hash
+	Sender       common.Address
+	Nonce        *big.Int
+	RevertReason []byte
+	Raw          *types.Log
+}
+
+// ParseUserOperationEvent decodes a UserOperationEvent log. It returns
+// ok=false if the log is not a UserOperationEvent.
+func ParseUserOperationEvent(log *types.Log) (*UserOperationEvent, bool, error) {
+	if len(log.Topics) != 4 || log.Topics[0] != UserOperationEventTopic {
+		return nil, false, nil
+	}
+	e := &UserOperationEvent{
+		UserOpHash: log.Topics[1],
+		Sender:     common.BytesToAddress(log.Topics[2].Bytes()),
+		Paymaster:  common.BytesToAddress(log.Topics[3].Bytes()),
+		Raw:        log,
+	}
+	if err := entryPointEvents.UnpackIntoInterface(e, "UserOperationEvent", log.Data); err != nil {
+		return nil, true, err
+	}
+	return e, true, nil
+}
+
+// ParseUserOperationRevertReason decodes a UserOperationRevertReason log. It
+// returns ok=false if the log is not a UserOperationRevertReason.
+func ParseUserOperationRevertReason(log *types.Log) (*UserOperationRevertReason, bool, error) {
+	if len(log.Topics) != 3 || log.Topics[0] != UserOperationRevertReasonTopic {
+		return nil, false, nil
+	}
+	e := &UserOperationRevertReason{
+		UserOpHash: log.Topics[1],
+		Sender:     common.BytesToAddress(log.Topics[2].Bytes()),
+		Raw:        log,
+	}
+	if err := entryPointEvents.UnpackIntoInterface(e, "UserOperationRevertReason", log.Data); err != nil {
+		return nil, true, err
+	}
+	return e, true, nil
+}
+
+// ParseAccountDeployed decodes an AccountDeployed log. It returns ok=false
+// if the log is not an AccountDeployed.
+func ParseAccountDeployed(log *types.Log) (*AccountDeployed, bool, error) {
+	if len(log.Topics) != 3 || log.Topics[0] != AccountDeployedTopic {
+		return nil, false, nil
+	}
+	e := &AccountDeployed{
+		UserOpHash: log.Topics[1],
+		Sender:     common.BytesToAddress(log.Topics[2].Bytes()),
+		Raw:        log,
+	}
+	if err := entryPointEvents.UnpackIntoInterface(e, "AccountDeployed", log.Data); err != nil {
+		return nil, true, err
+	}
+	return e, true, nil
+}
+
+// ParsePostOpRevertReason decodes a PostOpRevertReason log. It returns
+// ok=false if the log is not a PostOpRevertReason.
+func ParsePostOpRevertReason(log *types.Log) (*PostOpRevertReason, bool, error) {
+	if len(log.Topics) != 3 || log.Topics[0] != PostOpRevertReasonTopic {
+		return nil, false, nil
+	}
+	e := &PostOpRevertReason{
+		UserOpHash: log.Topics[1],
+		Sender:     common.BytesToAddress(log.Topics[2].Bytes()),
+		Raw:        log,
+	}
+	if err := entryPointEvents.UnpackIntoInterface(e, "PostOpRevertReason", log.Data); err != nil {
+		return nil, true, err
+	}
+	return e, true, nil
+}
+
+// DecodeEvents sets r.Event, r.RevertReason, r.AccountDeployed and
+// r.PostOpRevertReason from the EntryPoint logs for r.UserOpHash found in
+// r.Logs or the bundle transaction's logs.
+func (r *UserOperationReceipt) DecodeEvents() error {
+	logs := r.Logs
+	if r.Receipt != nil {
+		logs = append(logs[:len(logs):len(logs)], r.Receipt.Logs...)
+	}
+	for _, log := range logs {
+		if len(log.Topics) < 2 || log.Topics[1] != r.UserOpHash {
+			continue
+		}
+		if e, ok, err := ParseUserOperationEvent(log); ok {
+			if err != nil {
+				return err
+			}
+			r.Event = e
+		} else if e, ok, err := ParseUserOperationRevertReason(log); ok {
+			if err != nil {
+				return err
+			}
+			r.RevertReason = e
+		} else if e, ok, err := ParseAccountDeployed(log); ok {
+			if err != nil {
+				return err
+			}
+			r.AccountDeployed = e
+		} else if e, ok, err := ParsePostOpRevertReason(log); ok {
+			if err != nil {
+				return err
+			}
+			r.PostOpRevertReason = e
+		}
+	}
+	return nil
+}
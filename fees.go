@@ -0,0 +1,58 @@
+package bundler_client
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+type feeHistory struct {
+	BaseFee []*hexutil.Big `json:"baseFeePerGas"`
+}
+
+// SuggestGasFees suggests fees for a new op using the eth_* methods bundlers
+// proxy to their node, so no separate node connection is needed. The max fee
+// is twice the next block's base fee plus the suggested tip, leaving room for
+// the base fee to rise while the op waits in the mempool. Chains without
+// EIP-1559 get eth_gasPrice for both fees.
+func (c *RpcClient) SuggestGasFees(ctx context.Context) (*GasPrice, error) {
+	var history feeHistory
+	err := c.call(ctx, &history, "eth_feeHistory", hexutil.Uint64(1), "latest", []float64{})
+	if err != nil || len(history.BaseFee) == 0 {
+		gasPrice, err := c.gasPrice(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &GasPrice{MaxFeePerGas: gasPrice, MaxPriorityFeePerGas: new(big.Int).Set(gasPrice)}, nil
+	}
+	// The last entry is the base fee of the next block.
+	baseFee := history.BaseFee[len(history.BaseFee)-1].ToInt()
+
+	var result hexutil.Big
+	tip := (*big.Int)(&result)
+	if err := c.call(ctx, &result, "eth_maxPriorityFeePerGas", []interface{}{}...); err != nil {
+		// Not all bundlers proxy eth_maxPriorityFeePerGas; derive the tip
+		// from the legacy gas price instead.
+		gasPrice, err := c.gasPrice(ctx)
+		if err != nil {
+			return nil, err
+		}
+		tip = new(big.Int).Sub(gasPrice, baseFee)
+		if tip.Sign() < 0 {
+			tip.SetUint64(0)
+		}
+	}
+	maxFee := new(big.Int).Lsh(baseFee, 1)
+	maxFee.Add(maxFee, tip)
+	return &GasPrice{MaxFeePerGas: maxFee, MaxPriorityFeePerGas: tip}, nil
+}
+
+func (c *RpcClient) gasPrice(ctx context.Context) (*big.Int, error) {
+	var result hexutil.Big
+	err := c.call(ctx, &result, "eth_gasPrice", []interface{}{}...)
+	if err != nil {
+		return nil, err
+	}
+	return result.ToInt(), nil
+}
@@ -0,0 +1,172 @@
+package bundler_client
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// FeeSample is the fee paid by a confirmed op. EffectiveGasPrice is the
+// op's actualGasCost / actualGasUsed; MaxPriorityFeePerGas is the tip the op
+// was included with.
+type FeeSample struct {
+	Time                 time.Time
+	EffectiveGasPrice    *big.Int
+	MaxPriorityFeePerGas *big.Int
+}
+
+// FeeTracker keeps a rolling window of the fees paid by confirmed ops, as an
+// alternative fee oracle for bundlers without a fee endpoint. The window
+// holds at most Size samples no older than MaxAge (if non-zero).
+type FeeTracker struct {
+	Size   int
+	MaxAge time.Duration
+
+	mu      sync.Mutex
+	samples []FeeSample
+}
+
+func NewFeeTracker(size int, maxAge time.Duration) *FeeTracker {
+	return &FeeTracker{Size: size, MaxAge: maxAge}
+}
+
+// Observe records the fees of op from its receipt. Receipts with unparsable
+// or zero gas figures are ignored.
+func (t *FeeTracker) Observe(op *UserOperation, receipt *UserOperationReceipt) {
+	cost, err := parseTolerantBig(receipt.ActualGasCost)
+	if err != nil {
+		return
+	}
+	used, err := parseTolerantBig(receipt.ActualGasUsed)
+	if err != nil || used.Sign() == 0 {
+		return
+	}
+	t.Add(FeeSample{
+		Time:                 time.Now(),
+		EffectiveGasPrice:    cost.Div(cost, used),
+		MaxPriorityFeePerGas: new(big.Int).Set(op.MaxPriorityFeePerGas),
+	})
+}
+
+// Add records a sample, e.g. one reconstructed from historical receipts.
+// Samples are kept ordered by Time, so they may be added in any order; once
+// the window is full, the oldest are evicted.
+func (t *FeeTracker) Add(s FeeSample) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	i := sort.Search(len(t.samples), func(i int) bool { return t.samples[i].Time.After(s.Time) })
+	t.samples = append(t.samples, FeeSample{})
+	copy(t.samples[i+1:], t.samples[i:])
+	t.samples[i] = s
+	if t.Size > 0 && len(t.samples) > t.Size {
+		t.samples = append(t.samples[:0], t.samples[len(t.samples)-t.Size:]...)
+	}
+}
+
+// window returns the effective gas prices and priority fees of the samples
+// within MaxAge of now, pruning older ones.
+func (t *FeeTracker) window(now time.Time) (prices, tips []*big.Int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.MaxAge > 0 {
+		i := sort.Search(len(t.samples), func(i int) bool { return now.Sub(t.samples[i].Time) <= t.MaxAge })
+		t.samples = append(t.samples[:0], t.samples[i:]...)
+	}
+	prices = make([]*big.Int, len(t.samples))
+	tips = make([]*big.Int, len(t.samples))
+	for i, s := range t.samples {
+		prices[i], tips[i] = s.EffectiveGasPrice, s.MaxPriorityFeePerGas
+	}
+	return prices, tips
+}
+
+// Percentiles summarizes the effective gas prices and priority fees in the
+// current window.
+func (t *FeeTracker) Percentiles() (gasPrice, priorityFee FeePercentiles) {
+	prices, tips := t.window(time.Now())
+	return feePercentiles(prices), feePercentiles(tips)
+}
+
+// SuggestFees returns the q-th percentile (0 to 1) of the effective gas
+// prices and priority fees in the window, as maxFeePerGas and
+// maxPriorityFeePerGas. q outside [0, 1] is clamped. It returns false if
+// there are no samples.
+func (t *FeeTracker) SuggestFees(q float64) (maxFeePerGas, maxPriorityFeePerGas *big.Int, ok bool) {
+	if !(q > 0) { // also catches NaN
+		q = 0
+	} else if q > 1 {
+		q = 1
+	}
+	prices, tips := t.window(time.Now())
+	if len(prices) == 0 {
+		return nil, nil, false
+	}
+	maxFeePerGas, maxPriorityFeePerGas = percentile(prices, q), percentile(tips, q)
+	if maxFeePerGas.Cmp(maxPriorityFeePerGas) < 0 {
+		maxFeePerGas = maxPriorityFeePerGas
+	}
+	return new(big.Int).Set(maxFeePerGas), new(big.Int).Set(maxPriorityFeePerGas), true
+}
+
+func percentile(values []*big.Int, q float64) *big.Int {
+	sort.Slice(values, func(i, j int) bool { return values[i].Cmp(values[j]) < 0 })
+	return values[int(q*float64(len(values)-1))]
+}
+
+type feeTrackingClient struct {
+	Client
+	t *FeeTracker
+
+	mu      sync.Mutex
+	pending map[common.Hash]pendingFeeOp
+}
+
+type pendingFeeOp struct {
+	op     *UserOperation
+	sentAt time.Time
+}
+
+// NewFeeTrackingClient wraps c so that the fees of ops sent through it are
+// recorded in t once their receipt is fetched.
+func NewFeeTrackingClient(c Client, t *FeeTracker) Client {
+	return &feeTrackingClient{Client: c, t: t, pending: make(map[common.Hash]pendingFeeOp)}
+}
+
+func (c *feeTrackingClient) SendUserOperation(ctx context.Context, op *UserOperation, entryPoint common.Address) (common.Hash, error) {
+	hash, err := c.Client.SendUserOperation(ctx, op, entryPoint)
+	if err != nil {
+		return hash, err
+	}
+	now := time.Now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.pending[hash] = pendingFeeOp{op: op, sentAt: now}
+	// Forget ops whose receipts were never fetched.
+	if c.t.MaxAge > 0 {
+		for h, p := range c.pending {
+			if now.Sub(p.sentAt) > c.t.MaxAge {
+				delete(c.pending, h)
+			}
+		}
+	}
+	return hash, nil
+}
+
+func (c *feeTrackingClient) GetUserOperationReceipt(ctx context.Context, userOpHash common.Hash) (*UserOperationReceipt, error) {
+	receipt, err := c.Client.GetUserOperationReceipt(ctx, userOpHash)
+	if err != nil || receipt == nil {
+		return receipt, err
+	}
+	c.mu.Lock()
+	p, ok := c.pending[userOpHash]
+	delete(c.pending, userOpHash)
+	c.mu.Unlock()
+	if ok {
+		c.t.Observe(p.op, receipt)
+	}
+	return receipt, nil
+}
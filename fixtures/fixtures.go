@@ -0,0 +1,148 @@
+// Package fixtures generates realistic, deterministic user operations for
+// tests and load generation. The same seed always yields the same ops.
+package fixtures
+
+import (
+	"math/big"
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	bundler_client "github.com/mdehoog/go-bundler-client"
+)
+
+type Preset int
+
+const (
+	// Deployed is an op from an existing account paying its own gas.
+	Deployed Preset = iota
+	// Undeployed is an op carrying initCode to deploy its account.
+	Undeployed
+	// Sponsored is an op whose gas is paid by a verifying paymaster.
+	Sponsored
+	// TokenPaying is an op paying gas in ERC-20 tokens via a token
+	// paymaster.
+	TokenPaying
+)
+
+// executeSelector is SimpleAccount.execute(address,uint256,bytes).
+var executeSelector = []byte{0xb6, 0x1d, 0x27, 0xf6}
+
+type Generator struct {
+	rng *rand.Rand
+}
+
+func NewGenerator(seed int64) *Generator {
+	return &Generator{rng: rand.New(rand.NewSource(seed))}
+}
+
+func (g *Generator) bytes(n int) []byte {
+	b := make([]byte, n)
+	g.rng.Read(b)
+	return b
+}
+
+func (g *Generator) address() common.Address {
+	return common.BytesToAddress(g.bytes(common.AddressLength))
+}
+
+func (g *Generator) between(lo, hi int64) *big.Int {
+	return big.NewInt(lo + g.rng.Int63n(hi-lo+1))
+}
+
+func (g *Generator) word(v *big.Int) []byte {
+	return common.LeftPadBytes(v.Bytes(), 32)
+}
+
+// callData encodes a SimpleAccount.execute call with random target, value and
+// inner calldata.
+func (g *Generator) callData() []byte {
+	inner := g.bytes(4 + 32*g.rng.Intn(4))
+	data := append([]byte{}, executeSelector...)
+	data = append(data, g.word(new(big.Int).SetBytes(g.address().Bytes()))...)
+	data = append(data, g.word(g.between(0, params.GWei))...)
+	data = append(data, g.word(big.NewInt(96))...)
+	data = append(data, g.word(big.NewInt(int64(len(inner))))...)
+	return append(data, common.RightPadBytes(inner, (len(inner)+31)/32*32)...)
+}
+
+func (g *Generator) paymasterAndData(p Preset) []byte {
+	switch p {
+	case Sponsored:
+		// paymaster, validUntil, validAfter, 65 byte signature
+		data := g.address().Bytes()
+		data = append(data, g.word(big.NewInt(1<<40))...)
+		data = append(data, g.word(big.NewInt(0))...)
+		return append(data, g.bytes(65)...)
+	case TokenPaying:
+		// paymaster, token, max token cost
+		data := g.address().Bytes()
+		data = append(data, g.address().Bytes()...)
+		return append(data, g.word(g.between(1e6, 1e9))...)
+	}
+	return []byte{}
+}
+
+// UserOperation returns a v0.6 op for the given preset.
+func (g *Generator) UserOperation(p Preset) *bundler_client.UserOperation {
+	tip := g.between(params.GWei/10, 2*params.GWei)
+	op := &bundler_client.UserOperation{
+		Sender:               g.address(),
+		Nonce:                g.between(0, 100),
+		InitCode:             []byte{},
+		CallData:             g.callData(),
+		CallGasLimit:         g.between(30_000, 500_000),
+		VerificationGasLimit: g.between(70_000, 150_000),
+		PreVerificationGas:   g.between(45_000, 60_000),
+		MaxFeePerGas:         new(big.Int).Add(tip, g.between(params.GWei, 50*params.GWei)),
+		MaxPriorityFeePerGas: tip,
+		PaymasterAndData:     g.paymasterAndData(p),
+		Signature:            g.bytes(65),
+	}
+	if p == Undeployed {
+		// factory, createAccount(owner, salt)
+		op.Nonce = big.NewInt(0)
+		op.InitCode = append(g.address().Bytes(), 0x5f, 0xbf, 0xb9, 0xcf)
+		op.InitCode = append(op.InitCode, g.word(new(big.Int).SetBytes(g.address().Bytes()))...)
+		op.InitCode = append(op.InitCode, g.word(g.between(0, 1<<32))...)
+		op.VerificationGasLimit = g.between(300_000, 500_000)
+	}
+	return op
+}
+
+// PackedUserOperation returns a v0.7 op for the given preset.
+func (g *Generator) PackedUserOperation(p Preset) *bundler_client.PackedUserOperation {
+	op := g.UserOperation(p)
+	packed := &bundler_client.PackedUserOperation{
+		Sender:             op.Sender,
+		Nonce:              op.Nonce,
+		InitCode:           op.InitCode,
+		CallData:           op.CallData,
+		PreVerificationGas: op.PreVerificationGas,
+		Signature:          op.Signature,
+	}
+	copy(packed.AccountGasLimits[:16], common.LeftPadBytes(op.VerificationGasLimit.Bytes(), 16))
+	copy(packed.AccountGasLimits[16:], common.LeftPadBytes(op.CallGasLimit.Bytes(), 16))
+	copy(packed.GasFees[:16], common.LeftPadBytes(op.MaxPriorityFeePerGas.Bytes(), 16))
+	copy(packed.GasFees[16:], common.LeftPadBytes(op.MaxFeePerGas.Bytes(), 16))
+	if len(op.PaymasterAndData) >= common.AddressLength {
+		// v0.7 paymasterAndData is paymaster, verification gas limit,
+		// postOp gas limit, then paymaster data.
+		pm := append([]byte{}, op.PaymasterAndData[:common.AddressLength]...)
+		pm = append(pm, common.LeftPadBytes(g.between(50_000, 100_000).Bytes(), 16)...)
+		pm = append(pm, common.LeftPadBytes(g.between(20_000, 60_000).Bytes(), 16)...)
+		packed.PaymasterAndData = append(pm, op.PaymasterAndData[common.AddressLength:]...)
+	} else {
+		packed.PaymasterAndData = []byte{}
+	}
+	return packed
+}
+
+// UserOperations returns n v0.6 ops cycling through all presets.
+func (g *Generator) UserOperations(n int) []*bundler_client.UserOperation {
+	ops := make([]*bundler_client.UserOperation, n)
+	for i := range ops {
+		ops[i] = g.UserOperation(Preset(i % 4))
+	}
+	return ops
+}
@@ -0,0 +1,220 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: bundler.proto
+
+package bundlerpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Bundler_SendUserOperation_FullMethodName        = "/bundler.v1.Bundler/SendUserOperation"
+	Bundler_EstimateUserOperationGas_FullMethodName = "/bundler.v1.Bundler/EstimateUserOperationGas"
+	Bundler_GetUserOperationStatus_FullMethodName   = "/bundler.v1.Bundler/GetUserOperationStatus"
+	Bundler_WaitForReceipt_FullMethodName           = "/bundler.v1.Bundler/WaitForReceipt"
+)
+
+// BundlerClient is the client API for Bundler service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BundlerClient interface {
+	SendUserOperation(ctx context.Context, in *SendUserOperationRequest, opts ...grpc.CallOption) (*SendUserOperationResponse, error)
+	EstimateUserOperationGas(ctx context.Context, in *EstimateUserOperationGasRequest, opts ...grpc.CallOption) (*GasEstimates, error)
+	GetUserOperationStatus(ctx context.Context, in *GetUserOperationStatusRequest, opts ...grpc.CallOption) (*UserOperationStatus, error)
+	WaitForReceipt(ctx context.Context, in *WaitForReceiptRequest, opts ...grpc.CallOption) (*UserOperationReceipt, error)
+}
+
+type bundlerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBundlerClient(cc grpc.ClientConnInterface) BundlerClient {
+	return &bundlerClient{cc}
+}
+
+func (c *bundlerClient) SendUserOperation(ctx context.Context, in *SendUserOperationRequest, opts ...grpc.CallOption) (*SendUserOperationResponse, error) {
+	out := new(SendUserOperationResponse)
+	err := c.cc.Invoke(ctx, Bundler_SendUserOperation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bundlerClient) EstimateUserOperationGas(ctx context.Context, in *EstimateUserOperationGasRequest, opts ...grpc.CallOption) (*GasEstimates, error) {
+	out := new(GasEstimates)
+	err := c.cc.Invoke(ctx, Bundler_EstimateUserOperationGas_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bundlerClient) GetUserOperationStatus(ctx context.Context, in *GetUserOperationStatusRequest, opts ...grpc.CallOption) (*UserOperationStatus, error) {
+	out := new(UserOperationStatus)
+	err := c.cc.Invoke(ctx, Bundler_GetUserOperationStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bundlerClient) WaitForReceipt(ctx context.Context, in *WaitForReceiptRequest, opts ...grpc.CallOption) (*UserOperationReceipt, error) {
+	out := new(UserOperationReceipt)
+	err := c.cc.Invoke(ctx, Bundler_WaitForReceipt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BundlerServer is the server API for Bundler service.
+// All implementations must embed UnimplementedBundlerServer
+// for forward compatibility
+type BundlerServer interface {
+	SendUserOperation(context.Context, *SendUserOperationRequest) (*SendUserOperationResponse, error)
+	EstimateUserOperationGas(context.Context, *EstimateUserOperationGasRequest) (*GasEstimates, error)
+	GetUserOperationStatus(context.Context, *GetUserOperationStatusRequest) (*UserOperationStatus, error)
+	WaitForReceipt(context.Context, *WaitForReceiptRequest) (*UserOperationReceipt, error)
+	mustEmbedUnimplementedBundlerServer()
+}
+
+// UnimplementedBundlerServer must be embedded to have forward compatible implementations.
+type UnimplementedBundlerServer struct {
+}
+
+func (UnimplementedBundlerServer) SendUserOperation(context.Context, *SendUserOperationRequest) (*SendUserOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendUserOperation not implemented")
+}
+func (UnimplementedBundlerServer) EstimateUserOperationGas(context.Context, *EstimateUserOperationGasRequest) (*GasEstimates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateUserOperationGas not implemented")
+}
+func (UnimplementedBundlerServer) GetUserOperationStatus(context.Context, *GetUserOperationStatusRequest) (*UserOperationStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserOperationStatus not implemented")
+}
+func (UnimplementedBundlerServer) WaitForReceipt(context.Context, *WaitForReceiptRequest) (*UserOperationReceipt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitForReceipt not implemented")
+}
+func (UnimplementedBundlerServer) mustEmbedUnimplementedBundlerServer() {}
+
+// UnsafeBundlerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BundlerServer will
+// result in compilation errors.
+type UnsafeBundlerServer interface {
+	mustEmbedUnimplementedBundlerServer()
+}
+
+func RegisterBundlerServer(s grpc.ServiceRegistrar, srv BundlerServer) {
+	s.RegisterService(&Bundler_ServiceDesc, srv)
+}
+
+func _Bundler_SendUserOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendUserOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BundlerServer).SendUserOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bundler_SendUserOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BundlerServer).SendUserOperation(ctx, req.(*SendUserOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bundler_EstimateUserOperationGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateUserOperationGasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BundlerServer).EstimateUserOperationGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bundler_EstimateUserOperationGas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BundlerServer).EstimateUserOperationGas(ctx, req.(*EstimateUserOperationGasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bundler_GetUserOperationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserOperationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BundlerServer).GetUserOperationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bundler_GetUserOperationStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BundlerServer).GetUserOperationStatus(ctx, req.(*GetUserOperationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bundler_WaitForReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitForReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BundlerServer).WaitForReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bundler_WaitForReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BundlerServer).WaitForReceipt(ctx, req.(*WaitForReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Bundler_ServiceDesc is the grpc.ServiceDesc for Bundler service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Bundler_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bundler.v1.Bundler",
+	HandlerType: (*BundlerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SendUserOperation",
+			Handler:    _Bundler_SendUserOperation_Handler,
+		},
+		{
+			MethodName: "EstimateUserOperationGas",
+			Handler:    _Bundler_EstimateUserOperationGas_Handler,
+		},
+		{
+			MethodName: "GetUserOperationStatus",
+			Handler:    _Bundler_GetUserOperationStatus_Handler,
+		},
+		{
+			MethodName: "WaitForReceipt",
+			Handler:    _Bundler_WaitForReceipt_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bundler.proto",
+}
@@ -0,0 +1,306 @@
+// Package grpcserver exposes a bundler client over gRPC, so that backends
+// in other languages can reuse one bundler integration. The service is
+// defined in bundlerpb/bundler.proto.
+//
+// It is a separate module, so that the client does not depend on gRPC.
+// Transport security and authentication are left to the grpc.ServerOptions
+// of the server the service is registered with.
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+	bundler_client "github.com/mdehoog/go-bundler-client"
+	"github.com/mdehoog/go-bundler-client/grpcserver/bundlerpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// methodNotFound is the JSON-RPC error code for unsupported methods.
+const methodNotFound = -32601
+
+type Config struct {
+	// EntryPoint is used for requests that do not specify one.
+	EntryPoint common.Address
+	// PollInterval is how often WaitForReceipt polls for the receipt.
+	PollInterval time.Duration
+}
+
+type Server struct {
+	bundlerpb.UnimplementedBundlerServer
+
+	client bundler_client.Client
+	cfg    Config
+}
+
+func NewServer(client bundler_client.Client, cfg Config) *Server {
+	if cfg.PollInterval == 0 {
+		cfg.PollInterval = time.Second
+	}
+	return &Server{client: client, cfg: cfg}
+}
+
+// Register registers s with g.
+func (s *Server) Register(g *grpc.Server) {
+	bundlerpb.RegisterBundlerServer(g, s)
+}
+
+func (s *Server) SendUserOperation(ctx context.Context, req *bundlerpb.SendUserOperationRequest) (*bundlerpb.SendUserOperationResponse, error) {
+	op, ep, err := s.opRequest(req.GetUserOperation(), req.GetEntryPoint())
+	if err != nil {
+		return nil, err
+	}
+	hash, err := s.client.SendUserOperation(ctx, op, ep)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &bundlerpb.SendUserOperationResponse{UserOpHash: hash.Bytes()}, nil
+}
+
+func (s *Server) EstimateUserOperationGas(ctx context.Context, req *bundlerpb.EstimateUserOperationGasRequest) (*bundlerpb.GasEstimates, error) {
+	op, ep, err := s.opRequest(req.GetUserOperation(), req.GetEntryPoint())
+	if err != nil {
+		return nil, err
+	}
+	e, err := s.client.EstimateUserOperationGas(ctx, op, ep)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &bundlerpb.GasEstimates{
+		PreVerificationGas:            quantity(e.PreVerificationGas),
+		VerificationGasLimit:          quantity(e.VerificationGasLimit),
+		CallGasLimit:                  quantity(e.CallGasLimit),
+		PaymasterVerificationGasLimit: quantity(e.PaymasterVerificationGasLimit),
+		PaymasterPostOpGasLimit:       quantity(e.PaymasterPostOpGasLimit),
+	}, nil
+}
+
+// GetUserOperationStatus returns the bundler's eth_getUserOperationStatus
+// where supported, and otherwise derives the status from the op's receipt
+// and mempool entry.
+func (s *Server) GetUserOperationStatus(ctx context.Context, req *bundlerpb.GetUserOperationStatusRequest) (*bundlerpb.UserOperationStatus, error) {
+	hash, err := parseHash(req.GetUserOpHash())
+	if err != nil {
+		return nil, err
+	}
+	st, err := s.client.GetUserOperationStatus(ctx, hash)
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) && rpcErr.ErrorCode() == methodNotFound {
+		st, err = s.deriveStatus(ctx, hash)
+	}
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	res := &bundlerpb.UserOperationStatus{
+		Status:  opStatuses[st.Status],
+		Raw:     st.Raw,
+		Reason:  st.Reason,
+		Receipt: receipt(st.Receipt),
+	}
+	if st.TransactionHash != nil {
+		res.TransactionHash = st.TransactionHash.Bytes()
+	}
+	return res, nil
+}
+
+func (s *Server) deriveStatus(ctx context.Context, hash common.Hash) (*bundler_client.UserOperationStatus, error) {
+	r, err := s.client.GetUserOperationReceipt(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	if r != nil {
+		st := &bundler_client.UserOperationStatus{Status: bundler_client.OpStatusIncluded, Receipt: r}
+		if !r.Success {
+			st.Status = bundler_client.OpStatusReverted
+		}
+		if r.Receipt != nil {
+			st.TransactionHash = &r.Receipt.TransactionHash
+		}
+		return st, nil
+	}
+	lookup, err := s.client.GetUserOperationByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	if lookup != nil {
+		return &bundler_client.UserOperationStatus{Status: bundler_client.OpStatusPending}, nil
+	}
+	return &bundler_client.UserOperationStatus{Status: bundler_client.OpStatusUnknown}, nil
+}
+
+func (s *Server) WaitForReceipt(ctx context.Context, req *bundlerpb.WaitForReceiptRequest) (*bundlerpb.UserOperationReceipt, error) {
+	hash, err := parseHash(req.GetUserOpHash())
+	if err != nil {
+		return nil, err
+	}
+	t := time.NewTicker(s.cfg.PollInterval)
+	defer t.Stop()
+	for {
+		r, err := s.client.GetUserOperationReceipt(ctx, hash)
+		if err != nil {
+			return nil, toStatus(err)
+		}
+		if r != nil {
+			return receipt(r), nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, toStatus(ctx.Err())
+		case <-t.C:
+		}
+	}
+}
+
+func (s *Server) opRequest(pb *bundlerpb.UserOperation, entryPoint []byte) (*bundler_client.UserOperation, common.Address, error) {
+	if pb == nil {
+		return nil, common.Address{}, status.Error(codes.InvalidArgument, "missing user_operation")
+	}
+	op, err := userOperation(pb)
+	if err != nil {
+		return nil, common.Address{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ep := s.cfg.EntryPoint
+	if len(entryPoint) > 0 {
+		if ep, err = parseAddress("entry_point", entryPoint); err != nil {
+			return nil, common.Address{}, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	return op, ep, nil
+}
+
+func userOperation(pb *bundlerpb.UserOperation) (*bundler_client.UserOperation, error) {
+	sender, err := parseAddress("sender", pb.Sender)
+	if err != nil {
+		return nil, err
+	}
+	op := &bundler_client.UserOperation{
+		Sender:           sender,
+		InitCode:         pb.InitCode,
+		CallData:         pb.CallData,
+		PaymasterAndData: pb.PaymasterAndData,
+		Signature:        pb.Signature,
+	}
+	for _, q := range []struct {
+		name  string
+		value string
+		dst   **big.Int
+	}{
+		{"nonce", pb.Nonce, &op.Nonce},
+		{"call_gas_limit", pb.CallGasLimit, &op.CallGasLimit},
+		{"verification_gas_limit", pb.VerificationGasLimit, &op.VerificationGasLimit},
+		{"pre_verification_gas", pb.PreVerificationGas, &op.PreVerificationGas},
+		{"max_fee_per_gas", pb.MaxFeePerGas, &op.MaxFeePerGas},
+		{"max_priority_fee_per_gas", pb.MaxPriorityFeePerGas, &op.MaxPriorityFeePerGas},
+	} {
+		if *q.dst, err = parseQuantity(q.name, q.value); err != nil {
+			return nil, err
+		}
+	}
+	return op, nil
+}
+
+func parseAddress(field string, b []byte) (common.Address, error) {
+	if len(b) != common.AddressLength {
+		return common.Address{}, fmt.Errorf("%s: want %d bytes, have %d", field, common.AddressLength, len(b))
+	}
+	return common.BytesToAddress(b), nil
+}
+
+func parseHash(b []byte) (common.Hash, error) {
+	if len(b) != common.HashLength {
+		return common.Hash{}, status.Errorf(codes.InvalidArgument, "user_op_hash: want %d bytes, have %d", common.HashLength, len(b))
+	}
+	return common.BytesToHash(b), nil
+}
+
+// parseQuantity parses a decimal quantity, treating the empty string as
+// zero.
+func parseQuantity(field, s string) (*big.Int, error) {
+	if s == "" {
+		return new(big.Int), nil
+	}
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok || v.Sign() < 0 {
+		return nil, fmt.Errorf("%s: invalid quantity %q", field, s)
+	}
+	return v, nil
+}
+
+func quantity(v *big.Int) string {
+	if v == nil {
+		return ""
+	}
+	return v.String()
+}
+
+// receiptQuantity converts a receipt quantity, which bundlers return as a
+// hex or decimal string, to a decimal string.
+func receiptQuantity(s string) string {
+	if v, ok := new(big.Int).SetString(s, 0); ok {
+		return v.String()
+	}
+	return s
+}
+
+func receipt(r *bundler_client.UserOperationReceipt) *bundlerpb.UserOperationReceipt {
+	if r == nil {
+		return nil
+	}
+	pb := &bundlerpb.UserOperationReceipt{
+		UserOpHash:    r.UserOpHash.Bytes(),
+		EntryPoint:    r.EntryPoint.Bytes(),
+		Sender:        r.Sender.Bytes(),
+		Paymaster:     r.Paymaster.Bytes(),
+		Nonce:         receiptQuantity(r.Nonce),
+		Success:       r.Success,
+		ActualGasCost: receiptQuantity(r.ActualGasCost),
+		ActualGasUsed: receiptQuantity(r.ActualGasUsed),
+		Reason:        r.Reason,
+	}
+	if r.Receipt != nil {
+		pb.TransactionHash = r.Receipt.TransactionHash.Bytes()
+		if n, ok := new(big.Int).SetString(r.Receipt.BlockNumber, 0); ok && n.IsUint64() {
+			pb.BlockNumber = n.Uint64()
+		}
+	}
+	return pb
+}
+
+var opStatuses = map[bundler_client.OpStatus]bundlerpb.Status{
+	bundler_client.OpStatusPending:  bundlerpb.Status_STATUS_PENDING,
+	bundler_client.OpStatusIncluded: bundlerpb.Status_STATUS_INCLUDED,
+	bundler_client.OpStatusReverted: bundlerpb.Status_STATUS_REVERTED,
+	bundler_client.OpStatusDropped:  bundlerpb.Status_STATUS_DROPPED,
+	bundler_client.OpStatusReplaced: bundlerpb.Status_STATUS_REPLACED,
+}
+
+// toStatus maps client errors to gRPC statuses: bundler rejections keep
+// their JSON-RPC code and message, and anything else is reported as the
+// bundler being unavailable.
+func toStatus(err error) error {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	}
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		code := codes.FailedPrecondition
+		switch rpcErr.ErrorCode() {
+		case methodNotFound:
+			code = codes.Unimplemented
+		case -32602:
+			code = codes.InvalidArgument
+		}
+		return status.Errorf(code, "bundler error %d: %s", rpcErr.ErrorCode(), err.Error())
+	}
+	return status.Error(codes.Unavailable, err.Error())
+}
@@ -0,0 +1,60 @@
+package bundler_client
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// GetUserOpHash computes the hash a v0.6 EntryPoint assigns to op:
+// keccak256(abi.encode(keccak256(pack(op)), entryPoint, chainID)). It equals
+// the hash returned by eth_sendUserOperation, so it can be used to key local
+// state before submission or to check the bundler's answer.
+func GetUserOpHash(op *UserOperation, entryPoint common.Address, chainID *big.Int) common.Hash {
+	return userOpHash(PackUserOperationForHash(op), entryPoint, chainID)
+}
+
+// GetPackedUserOpHash is the v0.7 equivalent of GetUserOpHash.
+func GetPackedUserOpHash(op *PackedUserOperation, entryPoint common.Address, chainID *big.Int) (common.Hash, error) {
+	packed, err := PackPackedUserOperationForHash(op)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return userOpHash(packed, entryPoint, chainID), nil
+}
+
+var (
+	packedUserOpTypeHash = crypto.Keccak256Hash([]byte("PackedUserOperation(address sender,uint256 nonce,bytes initCode,bytes callData,bytes32 accountGasLimits,uint256 preVerificationGas,bytes32 gasFees,bytes paymasterAndData)"))
+	eip712DomainTypeHash = crypto.Keccak256Hash([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"))
+	entryPointDomainName = crypto.Keccak256Hash([]byte("ERC4337"))
+	entryPointDomainVer  = crypto.Keccak256Hash([]byte("1"))
+)
+
+// GetUserOpHashV08 computes the hash a v0.8 EntryPoint assigns to op, the
+// EIP-712 typed data hash of the packed op under the EntryPoint's domain.
+// Ops whose initCode carries the EIP-7702 marker are hashed with the
+// sender's delegate by the EntryPoint and are not supported.
+func GetUserOpHashV08(op *PackedUserOperation, entryPoint common.Address, chainID *big.Int) (common.Hash, error) {
+	packed, err := PackPackedUserOperationForHash(op)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	structHash := crypto.Keccak256Hash(packedUserOpTypeHash.Bytes(), packed)
+	domainSeparator := crypto.Keccak256Hash(
+		eip712DomainTypeHash.Bytes(),
+		entryPointDomainName.Bytes(),
+		entryPointDomainVer.Bytes(),
+		common.LeftPadBytes(chainID.Bytes(), 32),
+		common.LeftPadBytes(entryPoint.Bytes(), 32),
+	)
+	return crypto.Keccak256Hash([]byte{0x19, 0x01}, domainSeparator.Bytes(), structHash.Bytes()), nil
+}
+
+func userOpHash(packed []byte, entryPoint common.Address, chainID *big.Int) common.Hash {
+	return crypto.Keccak256Hash(
+		crypto.Keccak256(packed),
+		common.LeftPadBytes(entryPoint.Bytes(), 32),
+		common.LeftPadBytes(chainID.Bytes(), 32),
+	)
+}
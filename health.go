@@ -0,0 +1,101 @@
+package bundler_client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+var ErrNoEntryPoints = errors.New("bundler supports no entry points")
+
+// ProbeResult is the outcome of a single health probe.
+type ProbeResult struct {
+	Method  string        `json:"method"`
+	Latency time.Duration `json:"latency"`
+	Error   string        `json:"error,omitempty"`
+
+	err error
+}
+
+// HealthReport is the result of Healthcheck. ChainId and EntryPoints are set
+// when their probes succeeded.
+type HealthReport struct {
+	Healthy     bool             `json:"healthy"`
+	ChainId     *big.Int         `json:"chainId,omitempty"`
+	EntryPoints []common.Address `json:"entryPoints,omitempty"`
+	Probes      []ProbeResult    `json:"probes"`
+	CheckedAt   time.Time        `json:"checkedAt"`
+}
+
+// Err returns the error of the first failed probe, or nil if the bundler is
+// healthy.
+func (r *HealthReport) Err() error {
+	for _, p := range r.Probes {
+		if p.err != nil {
+			return fmt.Errorf("%s: %w", p.Method, p.err)
+		}
+	}
+	return nil
+}
+
+func (r *HealthReport) failLast(err error) {
+	p := &r.Probes[len(r.Probes)-1]
+	p.err = err
+	p.Error = err.Error()
+}
+
+// Healthcheck probes the bundler's chainId and supported entry points,
+// bypassing the WithCaching cache, and reports the latency and outcome of
+// each probe. The bundler is healthy if both succeed, it supports at least
+// one entry point and its chainId matches any cached one.
+func (c *RpcClient) Healthcheck(ctx context.Context) *HealthReport {
+	r := &HealthReport{CheckedAt: time.Now()}
+	probe := func(method string, result interface{}) error {
+		start := time.Now()
+		err := c.call(ctx, result, method, []interface{}{}...)
+		p := ProbeResult{Method: method, Latency: time.Since(start), err: err}
+		if err != nil {
+			p.Error = err.Error()
+		}
+		r.Probes = append(r.Probes, p)
+		return err
+	}
+	var chainId hexutil.Big
+	if err := probe("eth_chainId", &chainId); err == nil {
+		r.ChainId = chainId.ToInt()
+		if c.cache != nil {
+			if cached := c.cache.getChainId(); cached != nil && cached.Cmp(r.ChainId) != 0 {
+				r.failLast(fmt.Errorf("chainId changed from %s to %s", cached, r.ChainId))
+			}
+		}
+	}
+	var entryPoints []common.Address
+	if err := probe("eth_supportedEntryPoints", &entryPoints); err == nil {
+		r.EntryPoints = entryPoints
+		if len(entryPoints) == 0 {
+			r.failLast(ErrNoEntryPoints)
+		}
+	}
+	r.Healthy = r.Err() == nil
+	return r
+}
+
+// HealthHandler serves c's Healthcheck report as JSON, with status 200 when
+// healthy and 503 otherwise, for use as a readiness probe.
+func HealthHandler(c *RpcClient) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		r := c.Healthcheck(req.Context())
+		w.Header().Set("Content-Type", "application/json")
+		if !r.Healthy {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = json.NewEncoder(w).Encode(r)
+	})
+}
@@ -0,0 +1,48 @@
+package bundler_client
+
+import (
+	"context"
+	"time"
+)
+
+// CallInfo describes an RPC call attempt. Result, Duration and Err are set
+// only after the call.
+type CallInfo struct {
+	Method   string
+	Params   []interface{}
+	Attempt  int
+	Result   interface{}
+	Duration time.Duration
+	Err      error
+}
+
+// Hook observes every RPC call attempt, e.g. to log requests and responses
+// with slog or zap.
+type Hook interface {
+	BeforeCall(ctx context.Context, info *CallInfo)
+	AfterCall(ctx context.Context, info *CallInfo)
+}
+
+// HookFuncs adapts functions to a Hook. Nil functions are skipped.
+type HookFuncs struct {
+	Before func(ctx context.Context, info *CallInfo)
+	After  func(ctx context.Context, info *CallInfo)
+}
+
+func (h HookFuncs) BeforeCall(ctx context.Context, info *CallInfo) {
+	if h.Before != nil {
+		h.Before(ctx, info)
+	}
+}
+
+func (h HookFuncs) AfterCall(ctx context.Context, info *CallInfo) {
+	if h.After != nil {
+		h.After(ctx, info)
+	}
+}
+
+// WithHook registers h to observe every call. Hooks run synchronously, in
+// the order they were added.
+func WithHook(h Hook) DialOption {
+	return func(c *dialConfig) { c.hooks = append(c.hooks, h) }
+}
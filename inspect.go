@@ -0,0 +1,123 @@
+package bundler_client
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// BundleBackend is satisfied by *ethclient.Client.
+type BundleBackend interface {
+	TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+}
+
+// OpInspection is the outcome of a single op within a bundle. Ops that have
+// no UserOperationEvent in the receipt (e.g. because the bundle reverted)
+// have Included set to false.
+type OpInspection struct {
+	Index         int
+	Sender        common.Address
+	Nonce         *big.Int
+	UserOpHash    common.Hash
+	Included      bool
+	Success       bool
+	ActualGasCost *big.Int
+	ActualGasUsed *big.Int
+	RevertReason  []byte
+	// RevertMessage is the decoded Error(string) message of RevertReason,
+	// if any.
+	RevertMessage string
+}
+
+type BundleInspection struct {
+	TxHash      common.Hash
+	EntryPoint  common.Address
+	Status      uint64
+	GasUsed     uint64
+	BlockNumber *big.Int
+	Bundle      *DecodedBundle
+	Ops         []*OpInspection
+}
+
+type opKey struct {
+	sender common.Address
+	nonce  string
+}
+
+// InspectBundle decodes the bundle transaction txHash and combines it with
+// the receipt logs to report the per-op status, gas used and revert reason.
+// This explains cases where a bundler reports an op as included but its
+// execution reverted.
+func InspectBundle(ctx context.Context, backend BundleBackend, txHash common.Hash) (*BundleInspection, error) {
+	tx, _, err := backend.TransactionByHash(ctx, txHash)
+	if err != nil {
+		return nil, err
+	}
+	bundle, err := DecodeHandleOps(tx.Data())
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := backend.TransactionReceipt(ctx, txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	ins := &BundleInspection{
+		TxHash:      txHash,
+		Status:      receipt.Status,
+		GasUsed:     receipt.GasUsed,
+		BlockNumber: receipt.BlockNumber,
+		Bundle:      bundle,
+	}
+	if tx.To() != nil {
+		ins.EntryPoint = *tx.To()
+	}
+
+	byKey := make(map[opKey]*OpInspection)
+	add := func(i int, sender common.Address, nonce *big.Int) {
+		op := &OpInspection{Index: i, Sender: sender, Nonce: nonce}
+		ins.Ops = append(ins.Ops, op)
+		byKey[opKey{sender, nonce.String()}] = op
+	}
+	for i, op := range bundle.Ops {
+		add(i, op.Sender, op.Nonce)
+	}
+	for i, op := range bundle.PackedOps {
+		add(i, op.Sender, op.Nonce)
+	}
+
+	for _, log := range receipt.Logs {
+		if log.Address != ins.EntryPoint {
+			continue
+		}
+		if e, ok, err := ParseUserOperationEvent(log); ok {
+			if err != nil {
+				return nil, err
+			}
+			if op := byKey[opKey{e.Sender, e.Nonce.String()}]; op != nil {
+				op.UserOpHash = e.UserOpHash
+				op.Included = true
+				op.Success = e.Success
+				op.ActualGasCost = e.ActualGasCost
+				op.ActualGasUsed = e.ActualGasUsed
+			}
+			continue
+		}
+		if e, ok, err := ParseUserOperationRevertReason(log); ok {
+			if err != nil {
+				return nil, err
+			}
+			if op := byKey[opKey{e.Sender, e.Nonce.String()}]; op != nil {
+				op.RevertReason = e.RevertReason
+				if msg, err := abi.UnpackRevert(e.RevertReason); err == nil {
+					op.RevertMessage = msg
+				}
+			}
+		}
+	}
+	return ins, nil
+}
@@ -0,0 +1,114 @@
+package bundler_client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// SizeLimits bounds the size of user operation fields, in bytes. Zero means
+// unlimited.
+type SizeLimits struct {
+	MaxCallData int
+	MaxInitCode int
+	// MaxOpSize bounds the ABI-encoded size of the whole op.
+	MaxOpSize int
+}
+
+// The limits below are derived from the size of the transaction a bundle
+// must fit into, as enforced by each chain's nodes. Bundlers may enforce
+// lower limits of their own, which they do not expose over RPC; register
+// those with RegisterSizeLimits where they are known.
+
+// DefaultSizeLimits applies to chains without registered limits. A bundle
+// must fit within a single transaction, which geth-derived txpools cap at
+// 128KiB.
+var DefaultSizeLimits = SizeLimits{MaxOpSize: 128 * 1024}
+
+// arbitrumSizeLimits reflects the Nitro sequencer's 95000 byte cap on
+// transaction data.
+var arbitrumSizeLimits = SizeLimits{MaxOpSize: 95000}
+
+var (
+	chainSizeLimitsMu sync.RWMutex
+	chainSizeLimits   = map[uint64]SizeLimits{
+		// Ethereum, OP-Stack (op-geth) and Polygon PoS (bor) nodes keep
+		// geth's txpool limit.
+		1:        DefaultSizeLimits,
+		11155111: DefaultSizeLimits,
+		10:       DefaultSizeLimits,
+		8453:     DefaultSizeLimits,
+		7777777:  DefaultSizeLimits,
+		11155420: DefaultSizeLimits,
+		84532:    DefaultSizeLimits,
+		137:      DefaultSizeLimits,
+		80002:    DefaultSizeLimits,
+		42161:    arbitrumSizeLimits,
+		42170:    arbitrumSizeLimits,
+		421614:   arbitrumSizeLimits,
+	}
+)
+
+// RegisterSizeLimits sets the size limits used for the given chain.
+func RegisterSizeLimits(chainId uint64, l SizeLimits) {
+	chainSizeLimitsMu.Lock()
+	defer chainSizeLimitsMu.Unlock()
+	chainSizeLimits[chainId] = l
+}
+
+func SizeLimitsForChain(chainId uint64) SizeLimits {
+	chainSizeLimitsMu.RLock()
+	defer chainSizeLimitsMu.RUnlock()
+	if l, ok := chainSizeLimits[chainId]; ok {
+		return l
+	}
+	return DefaultSizeLimits
+}
+
+// OpSizeError is returned when a user operation field exceeds its size limit.
+type OpSizeError struct {
+	Field string
+	Size  int
+	Limit int
+}
+
+func (e *OpSizeError) Error() string {
+	return fmt.Sprintf("%s size %d exceeds limit %d", e.Field, e.Size, e.Limit)
+}
+
+// ValidateOpSize checks op against l, returning an *OpSizeError for the
+// first field that exceeds its limit.
+func ValidateOpSize(op *UserOperation, l SizeLimits) error {
+	if l.MaxCallData > 0 && len(op.CallData) > l.MaxCallData {
+		return &OpSizeError{Field: "callData", Size: len(op.CallData), Limit: l.MaxCallData}
+	}
+	if l.MaxInitCode > 0 && len(op.InitCode) > l.MaxInitCode {
+		return &OpSizeError{Field: "initCode", Size: len(op.InitCode), Limit: l.MaxInitCode}
+	}
+	if l.MaxOpSize > 0 {
+		packed, err := op.Pack()
+		if err != nil {
+			return err
+		}
+		if size := len(packed); size > l.MaxOpSize {
+			return &OpSizeError{Field: "userOperation", Size: size, Limit: l.MaxOpSize}
+		}
+	}
+	return nil
+}
+
+// SizeLimitPolicy is a Policy validating op sizes against the limits of the
+// chain served by the client's bundler.
+type SizeLimitPolicy struct {
+	Client EthClient
+}
+
+func (p *SizeLimitPolicy) Check(ctx context.Context, op *UserOperation, _ common.Address) error {
+	chainId, err := p.Client.ChainId(ctx)
+	if err != nil {
+		return err
+	}
+	return ValidateOpSize(op, SizeLimitsForChain(chainId.Uint64()))
+}
@@ -0,0 +1,135 @@
+package bundler_client
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const entryPointV06SimulateHandleOpABI = `[
+	{"type":"function","name":"simulateHandleOp","inputs":[{"name":"op","type":"tuple","components":[
+		{"name":"sender","type":"address"},{"name":"nonce","type":"uint256"},{"name":"initCode","type":"bytes"},{"name":"callData","type":"bytes"},
+		{"name":"callGasLimit","type":"uint256"},{"name":"verificationGasLimit","type":"uint256"},{"name":"preVerificationGas","type":"uint256"},
+		{"name":"maxFeePerGas","type":"uint256"},{"name":"maxPriorityFeePerGas","type":"uint256"},{"name":"paymasterAndData","type":"bytes"},{"name":"signature","type":"bytes"}]},
+		{"name":"target","type":"address"},{"name":"targetCallData","type":"bytes"}],"outputs":[]},
+	{"type":"error","name":"ExecutionResult","inputs":[{"name":"preOpGas","type":"uint256"},{"name":"paid","type":"uint256"},{"name":"validAfter","type":"uint48"},{"name":"validUntil","type":"uint48"},{"name":"targetSuccess","type":"bool"},{"name":"targetResult","type":"bytes"}]},
+	{"type":"error","name":"FailedOp","inputs":[{"name":"opIndex","type":"uint256"},{"name":"reason","type":"string"}]}
+]`
+
+var entryPointV06SimulateHandleOp, _ = abi.JSON(strings.NewReader(entryPointV06SimulateHandleOpABI))
+
+var ErrUndeployedAccount = errors.New("local callGasLimit estimation requires a deployed account")
+
+// LocalEstimator estimates gas for v0.6 ops without a bundler, by binary
+// search over eth_calls: verificationGasLimit is the smallest limit for which
+// the EntryPoint's simulateHandleOp does not fail the op, and callGasLimit
+// the smallest gas for which the account's call from the EntryPoint
+// succeeds. preVerificationGas is computed locally with PVG.
+//
+// Ops are simulated with zero fees, so no deposit is needed, and must carry
+// a dummy signature of the right size. callGasLimit can only be estimated
+// for deployed accounts.
+type LocalEstimator struct {
+	Backend    ethereum.ContractCaller
+	EntryPoint common.Address
+	PVG        PVGConfig
+	// MaxGas is the upper bound of each search, and Tolerance the precision
+	// at which the search stops.
+	MaxGas    uint64
+	Tolerance uint64
+}
+
+func NewLocalEstimator(backend ethereum.ContractCaller, entryPoint common.Address) *LocalEstimator {
+	return &LocalEstimator{
+		Backend:    backend,
+		EntryPoint: entryPoint,
+		PVG:        DefaultPVGConfig,
+		MaxGas:     10_000_000,
+		Tolerance:  1_000,
+	}
+}
+
+func (e *LocalEstimator) Estimate(ctx context.Context, op *UserOperation) (*GasEstimates, error) {
+	if len(op.InitCode) > 0 {
+		return nil, ErrUndeployedAccount
+	}
+	pvg, err := e.PVG.CalcPreVerificationGas(op)
+	if err != nil {
+		return nil, err
+	}
+
+	sim := *op
+	sim.PreVerificationGas = pvg
+	sim.CallGasLimit = new(big.Int)
+	sim.MaxFeePerGas = new(big.Int)
+	sim.MaxPriorityFeePerGas = new(big.Int)
+	vgl, err := e.search(ctx, func(gas uint64) error {
+		sim.VerificationGasLimit = new(big.Int).SetUint64(gas)
+		return e.simulateHandleOp(ctx, &sim)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cgl, err := e.search(ctx, func(gas uint64) error {
+		_, err := e.Backend.CallContract(ctx, ethereum.CallMsg{From: e.EntryPoint, To: &op.Sender, Gas: gas, Data: op.CallData}, nil)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	estimate := &GasEstimates{
+		PreVerificationGas:   pvg,
+		VerificationGasLimit: new(big.Int).SetUint64(vgl),
+		CallGasLimit:         new(big.Int).SetUint64(cgl),
+	}
+	resolveEstimateAliases(estimate)
+	return estimate, nil
+}
+
+// search returns the smallest gas, to within Tolerance, for which try
+// succeeds. If try fails at MaxGas, its error is returned.
+func (e *LocalEstimator) search(ctx context.Context, try func(gas uint64) error) (uint64, error) {
+	lo, hi := uint64(0), e.MaxGas
+	if err := try(hi); err != nil {
+		return 0, err
+	}
+	for hi-lo > e.Tolerance {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		mid := lo + (hi-lo)/2
+		if try(mid) == nil {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	return hi, nil
+}
+
+// simulateHandleOp returns nil if op passes simulateHandleOp and a
+// *FailedOpError if it fails.
+func (e *LocalEstimator) simulateHandleOp(ctx context.Context, op *UserOperation) error {
+	_, err := simulateHandleOp(ctx, e.Backend, e.EntryPoint, op, common.Address{}, nil)
+	return err
+}
+
+// EstimateLocally estimates gas with the bundler, falling back to a
+// LocalEstimator eth_calling through the same connection if the bundler
+// does not implement eth_estimateUserOperationGas.
+func (c *RpcClient) EstimateLocally(ctx context.Context, op *UserOperation, entryPoint common.Address) (*GasEstimates, error) {
+	estimate, err := c.EstimateUserOperationGas(ctx, op, entryPoint)
+	var rpcErr rpc.Error
+	if !errors.As(err, &rpcErr) || rpcErr.ErrorCode() != methodNotFound {
+		return estimate, err
+	}
+	return NewLocalEstimator(c, entryPoint).Estimate(ctx, op)
+}
@@ -0,0 +1,104 @@
+package bundler_client
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MempoolEntry is a debug_bundler_dumpMempool entry together with any vendor
+// metadata the bundler attached to it. Metadata fields are nil or empty when
+// the bundler does not report them; unrecognized fields are kept in Extra.
+type MempoolEntry struct {
+	UserOperation *UserOperation
+	SubmittedAt   *time.Time
+	MempoolId     string
+	Status        string
+	Extra         map[string]json.RawMessage
+}
+
+var (
+	mempoolEntryOpKeys      = []string{"userOp", "userOperation"}
+	mempoolEntryTimeKeys    = []string{"submittedTime", "submittedAt", "timestamp", "lastUpdatedTime"}
+	mempoolEntryMempoolKeys = []string{"mempoolId", "mempool"}
+	mempoolEntryStatusKeys  = []string{"status"}
+)
+
+var userOperationFields = map[string]bool{
+	"sender": true, "nonce": true, "initCode": true, "callData": true, "callGasLimit": true,
+	"verificationGasLimit": true, "preVerificationGas": true, "maxFeePerGas": true,
+	"maxPriorityFeePerGas": true, "paymasterAndData": true, "signature": true, "eip7702Auth": true,
+}
+
+func (e *MempoolEntry) UnmarshalJSON(input []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(input, &fields); err != nil {
+		return err
+	}
+
+	// Entries either wrap the op in a nested object, or carry the op fields
+	// at the top level alongside the metadata.
+	opJSON := input
+	if key, raw := takeField(fields, mempoolEntryOpKeys); key != "" {
+		opJSON = raw
+	} else {
+		for k := range userOperationFields {
+			delete(fields, k)
+		}
+	}
+	var op UserOperation
+	if err := json.Unmarshal(opJSON, &op); err != nil {
+		return err
+	}
+	e.UserOperation = &op
+
+	if key, raw := takeField(fields, mempoolEntryTimeKeys); key != "" {
+		var ts TolerantBig
+		if err := json.Unmarshal(raw, &ts); err == nil && ts.ToInt().IsInt64() {
+			t := unixTime(ts.ToInt().Int64())
+			e.SubmittedAt = &t
+		}
+	}
+	if key, raw := takeField(fields, mempoolEntryMempoolKeys); key != "" {
+		_ = json.Unmarshal(raw, &e.MempoolId)
+	}
+	if key, raw := takeField(fields, mempoolEntryStatusKeys); key != "" {
+		_ = json.Unmarshal(raw, &e.Status)
+	}
+	if len(fields) > 0 {
+		e.Extra = fields
+	}
+	return nil
+}
+
+func takeField(fields map[string]json.RawMessage, keys []string) (string, json.RawMessage) {
+	for _, k := range keys {
+		if raw, ok := fields[k]; ok {
+			delete(fields, k)
+			return k, raw
+		}
+	}
+	return "", nil
+}
+
+// unixTime interprets ts as seconds or, for values too large to be a
+// plausible seconds timestamp, milliseconds.
+func unixTime(ts int64) time.Time {
+	if ts > 1e12 {
+		return time.UnixMilli(ts)
+	}
+	return time.Unix(ts, 0)
+}
+
+// BundlerDumpMempoolEntries is like BundlerDumpMempool, but retains any
+// vendor metadata attached to each entry.
+func (c *RpcClient) BundlerDumpMempoolEntries(ctx context.Context, entryPoint common.Address) ([]*MempoolEntry, error) {
+	var entries []*MempoolEntry
+	err := c.call(ctx, &entries, "debug_bundler_dumpMempool", entryPoint)
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
@@ -0,0 +1,93 @@
+package bundler_client
+
+import (
+	"context"
+	"expvar"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MempoolMonitor periodically dumps the bundler mempool and exports its
+// depth, oldest-op age and per-paymaster pending counts as expvar metrics,
+// giving early warning of stuck pools. It is a Service.
+type MempoolMonitor struct {
+	loop
+
+	client     DebugClient
+	entryPoint common.Address
+	interval   time.Duration
+	analyzer   *MempoolAnalyzer
+
+	// OnReport, if set, is called with every report. OnError, if set, is
+	// called when a dump fails.
+	OnReport func(*MempoolReport)
+	OnError  func(error)
+
+	vars      *expvar.Map
+	depth     expvar.Int
+	oldestAge expvar.Float
+	paymaster *expvar.Map
+	errors    expvar.Int
+}
+
+func NewMempoolMonitor(client DebugClient, entryPoint common.Address, interval time.Duration) *MempoolMonitor {
+	m := &MempoolMonitor{
+		client:     client,
+		entryPoint: entryPoint,
+		interval:   interval,
+		analyzer:   NewMempoolAnalyzer(),
+		vars:       new(expvar.Map).Init(),
+		paymaster:  new(expvar.Map).Init(),
+	}
+	m.vars.Set("depth", &m.depth)
+	m.vars.Set("oldest_age_seconds", &m.oldestAge)
+	m.vars.Set("pending_by_paymaster", m.paymaster)
+	m.vars.Set("errors", &m.errors)
+	m.run = m.poll
+	return m
+}
+
+// Vars returns the monitor's metrics, for publishing via expvar.Publish.
+func (m *MempoolMonitor) Vars() expvar.Var {
+	return m.vars
+}
+
+func (m *MempoolMonitor) poll(ctx context.Context) {
+	t := time.NewTicker(m.interval)
+	defer t.Stop()
+	for {
+		m.sample(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+func (m *MempoolMonitor) sample(ctx context.Context) {
+	ops, err := m.client.BundlerDumpMempool(ctx, m.entryPoint)
+	if err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		m.errors.Add(1)
+		if m.OnError != nil {
+			m.OnError(err)
+		}
+		return
+	}
+	r := m.analyzer.Analyze(ops, time.Now())
+	m.depth.Set(int64(r.Total))
+	m.oldestAge.Set(r.OldestAge.Seconds())
+	m.paymaster.Init()
+	for pm, n := range r.ByPaymaster {
+		v := new(expvar.Int)
+		v.Set(int64(n))
+		m.paymaster.Set(pm.Hex(), v)
+	}
+	if m.OnReport != nil {
+		m.OnReport(r)
+	}
+}
@@ -0,0 +1,105 @@
+package bundler_client
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Most bundlers proxy the standard eth_ methods to their node. The methods
+// below mirror their *ethclient.Client counterparts, so that the client can
+// stand in for a node connection wherever the package expects a backend
+// (ethereum.ContractCaller, CodeReader, BundleBackend's receipts).
+
+func blockArg(blockNumber *big.Int) string {
+	if blockNumber == nil {
+		return "latest"
+	}
+	return hexutil.EncodeBig(blockNumber)
+}
+
+// CallContract implements ethereum.ContractCaller over eth_call, letting the
+// client back helpers such as NonceManager and the chain adapters without a
+// separate node connection.
+func (c *RpcClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	arg := map[string]interface{}{"from": msg.From, "data": hexutil.Bytes(msg.Data)}
+	if msg.To != nil {
+		arg["to"] = msg.To
+	}
+	if msg.Gas != 0 {
+		arg["gas"] = hexutil.Uint64(msg.Gas)
+	}
+	if msg.Value != nil {
+		arg["value"] = (*hexutil.Big)(msg.Value)
+	}
+	var result hexutil.Bytes
+	err := c.call(ctx, &result, "eth_call", arg, blockArg(blockNumber))
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CodeAt returns the code of account at the given block, or the latest block
+// if blockNumber is nil.
+func (c *RpcClient) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	var result hexutil.Bytes
+	err := c.call(ctx, &result, "eth_getCode", account, blockArg(blockNumber))
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// BalanceAt returns the wei balance of account at the given block, or the
+// latest block if blockNumber is nil.
+func (c *RpcClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	var result hexutil.Big
+	err := c.call(ctx, &result, "eth_getBalance", account, blockArg(blockNumber))
+	if err != nil {
+		return nil, err
+	}
+	return (*big.Int)(&result), nil
+}
+
+// NonceAt returns the transaction count of account at the given block, or
+// the latest block if blockNumber is nil. Smart accounts use the EntryPoint
+// nonce instead; see GetNonce.
+func (c *RpcClient) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	var result hexutil.Uint64
+	err := c.call(ctx, &result, "eth_getTransactionCount", account, blockArg(blockNumber))
+	return uint64(result), err
+}
+
+// BlockNumber returns the number of the most recent block.
+func (c *RpcClient) BlockNumber(ctx context.Context) (uint64, error) {
+	var result hexutil.Uint64
+	err := c.call(ctx, &result, "eth_blockNumber", []interface{}{}...)
+	return uint64(result), err
+}
+
+// HeaderByNumber returns the header of the given block, or the latest block
+// if number is nil.
+func (c *RpcClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	var h *types.Header
+	err := c.call(ctx, &h, "eth_getBlockByNumber", blockArg(number), false)
+	if err == nil && h == nil {
+		return nil, ethereum.NotFound
+	}
+	return h, err
+}
+
+// TransactionReceipt returns the receipt of a mined transaction, or
+// ethereum.NotFound if it is unknown or pending.
+func (c *RpcClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	var r *types.Receipt
+	err := c.call(ctx, &r, "eth_getTransactionReceipt", txHash)
+	if err == nil && r == nil {
+		return nil, ethereum.NotFound
+	}
+	return r, err
+}
@@ -0,0 +1,128 @@
+package bundler_client
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const entryPointNonceABI = `[
+	{"type":"function","name":"getNonce","stateMutability":"view","inputs":[{"name":"sender","type":"address"},{"name":"key","type":"uint192"}],"outputs":[{"name":"nonce","type":"uint256"}]}
+]`
+
+var entryPointNonce, _ = abi.JSON(strings.NewReader(entryPointNonceABI))
+
+// NonceKey returns the key of a 2D nonce, its high 192 bits.
+func NonceKey(nonce *big.Int) *big.Int {
+	return new(big.Int).Rsh(nonce, 64)
+}
+
+// NonceSequence returns the sequence of a 2D nonce, its low 64 bits.
+func NonceSequence(nonce *big.Int) uint64 {
+	return new(big.Int).And(nonce, new(big.Int).SetUint64(^uint64(0))).Uint64()
+}
+
+// PackNonce returns the 2D nonce with the given key and sequence.
+func PackNonce(key *big.Int, seq uint64) *big.Int {
+	nonce := new(big.Int).Lsh(key, 64)
+	return nonce.Or(nonce, new(big.Int).SetUint64(seq))
+}
+
+// GetNonce eth_calls EntryPoint.getNonce, returning the next nonce of sender
+// for key, key and sequence packed.
+func GetNonce(ctx context.Context, backend ethereum.ContractCaller, entryPoint, sender common.Address, key *big.Int) (*big.Int, error) {
+	input, err := entryPointNonce.Pack("getNonce", sender, key)
+	if err != nil {
+		return nil, err
+	}
+	out, err := backend.CallContract(ctx, ethereum.CallMsg{To: &entryPoint, Data: input}, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := entryPointNonce.Unpack("getNonce", out)
+	if err != nil {
+		return nil, err
+	}
+	return res[0].(*big.Int), nil
+}
+
+type nonceSlot struct {
+	sender common.Address
+	key    string
+}
+
+// nonceState is the next nonce of a slot. Its mu is held while the nonce is
+// read from the EntryPoint, so that the read blocks the slot only.
+type nonceState struct {
+	mu   sync.Mutex
+	next *big.Int
+}
+
+// NonceManager hands out nonces for new ops. The first nonce of each
+// (sender, key) pair is read from the EntryPoint; later ones are assigned
+// locally so that several ops can be in flight at once. Ops using distinct
+// keys are independent and can be included in any order.
+type NonceManager struct {
+	Backend    ethereum.ContractCaller
+	EntryPoint common.Address
+
+	mu    sync.Mutex
+	slots map[nonceSlot]*nonceState
+}
+
+func NewNonceManager(backend ethereum.ContractCaller, entryPoint common.Address) *NonceManager {
+	return &NonceManager{Backend: backend, EntryPoint: entryPoint}
+}
+
+func (m *NonceManager) slot(sender common.Address, key *big.Int) *nonceState {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	slot := nonceSlot{sender, key.String()}
+	s, ok := m.slots[slot]
+	if !ok {
+		if m.slots == nil {
+			m.slots = make(map[nonceSlot]*nonceState)
+		}
+		s = &nonceState{}
+		m.slots[slot] = s
+	}
+	return s
+}
+
+// Next returns the nonce to use for sender's next op on key (nil for the
+// default key 0).
+func (m *NonceManager) Next(ctx context.Context, sender common.Address, key *big.Int) (*big.Int, error) {
+	if key == nil {
+		key = new(big.Int)
+	}
+	s := m.slot(sender, key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	nonce := s.next
+	if nonce == nil {
+		var err error
+		if nonce, err = GetNonce(ctx, m.Backend, m.EntryPoint, sender, key); err != nil {
+			return nil, err
+		}
+	}
+	s.next = new(big.Int).Add(nonce, common.Big1)
+	return nonce, nil
+}
+
+// Reset forgets the locally assigned nonces of sender on key, so that the
+// next one is read from the EntryPoint again. Call it when an op was dropped
+// or rejected.
+func (m *NonceManager) Reset(sender common.Address, key *big.Int) {
+	if key == nil {
+		key = new(big.Int)
+	}
+	s := m.slot(sender, key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.next = nil
+}
@@ -0,0 +1,71 @@
+package bundler_client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// TolerantBig is a big integer that decodes from hex strings ("0x1f"),
+// decimal strings ("31") and bare JSON numbers (31). It always encodes as a
+// hex string.
+type TolerantBig big.Int
+
+func (b *TolerantBig) UnmarshalJSON(input []byte) error {
+	input = bytes.TrimSpace(input)
+	if string(input) == "null" {
+		return nil
+	}
+	s := string(input)
+	if len(input) > 0 && input[0] == '"' {
+		if err := json.Unmarshal(input, &s); err != nil {
+			return err
+		}
+	}
+	i, err := parseTolerantBig(s)
+	if err != nil {
+		return err
+	}
+	*b = TolerantBig(*i)
+	return nil
+}
+
+func (b *TolerantBig) MarshalJSON() ([]byte, error) {
+	return json.Marshal("0x" + b.ToInt().Text(16))
+}
+
+func (b *TolerantBig) ToInt() *big.Int {
+	if b == nil {
+		return nil
+	}
+	return (*big.Int)(b)
+}
+
+func parseTolerantBig(s string) (*big.Int, error) {
+	s = strings.TrimSpace(s)
+	base := 10
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		s = s[2:]
+		base = 16
+		if s == "" {
+			return new(big.Int), nil
+		}
+	}
+	i, ok := new(big.Int).SetString(s, base)
+	if !ok || i.Sign() < 0 {
+		return nil, fmt.Errorf("invalid numeric value %q", s)
+	}
+	return i, nil
+}
+
+// normalizeHex rewrites a decimal or hex numeric string as canonical hex,
+// leaving unparseable values untouched.
+func normalizeHex(s string) string {
+	i, err := parseTolerantBig(s)
+	if err != nil {
+		return s
+	}
+	return "0x" + i.Text(16)
+}
@@ -0,0 +1,132 @@
+package bundler_client
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// GasPriceOracleAddress is the OP-Stack GasPriceOracle predeploy.
+var GasPriceOracleAddress = common.HexToAddress("0x420000000000000000000000000000000000000F")
+
+const gasPriceOracleABI = `[
+	{"type":"function","name":"getL1Fee","stateMutability":"view","inputs":[{"name":"_data","type":"bytes"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"getL1FeeUpperBound","stateMutability":"view","inputs":[{"name":"_unsignedTxSize","type":"uint256"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"l1BaseFee","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"blobBaseFee","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"baseFeeScalar","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint32"}]},
+	{"type":"function","name":"blobBaseFeeScalar","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint32"}]}
+]`
+
+var gasPriceOracle, _ = abi.JSON(strings.NewReader(gasPriceOracleABI))
+
+// EcotoneFeeParams are the GasPriceOracle inputs to the Ecotone L1 fee
+// formula.
+type EcotoneFeeParams struct {
+	L1BaseFee         *big.Int
+	BlobBaseFee       *big.Int
+	BaseFeeScalar     uint32
+	BlobBaseFeeScalar uint32
+}
+
+// EcotoneL1Fee computes the Ecotone L1 data fee for data locally, matching
+// GasPriceOracle._getL1FeeEcotone. Chains past Fjord price data by its
+// FastLZ-compressed size instead; use OPStackL1FeeEstimator.L1Fee for those.
+func EcotoneL1Fee(data []byte, p EcotoneFeeParams) *big.Int {
+	var calldataGas int64
+	for _, b := range data {
+		if b == 0 {
+			calldataGas += 4
+		} else {
+			calldataGas += 16
+		}
+	}
+	// Unsigned transaction data excludes the signature, which the oracle
+	// accounts for with a fixed 68 byte padding.
+	calldataGas += 68 * 16
+
+	scaledBaseFee := new(big.Int).Mul(big.NewInt(int64(p.BaseFeeScalar)*16), p.L1BaseFee)
+	scaledBlobBaseFee := new(big.Int).Mul(big.NewInt(int64(p.BlobBaseFeeScalar)), p.BlobBaseFee)
+	fee := new(big.Int).Add(scaledBaseFee, scaledBlobBaseFee)
+	fee.Mul(fee, big.NewInt(calldataGas))
+	return fee.Div(fee, big.NewInt(16*1e6))
+}
+
+// OPStackL1FeeEstimator computes the L1 data fee component on OP-Stack chains
+// (Optimism, Base, Zora) via the GasPriceOracle predeploy, which applies
+// whichever of the Bedrock, Ecotone or Fjord formulas is active.
+type OPStackL1FeeEstimator struct {
+	Backend ethereum.ContractCaller
+}
+
+func NewOPStackL1FeeEstimator(backend ethereum.ContractCaller) *OPStackL1FeeEstimator {
+	return &OPStackL1FeeEstimator{Backend: backend}
+}
+
+func (e *OPStackL1FeeEstimator) call(ctx context.Context, method string, args ...interface{}) ([]interface{}, error) {
+	input, err := gasPriceOracle.Pack(method, args...)
+	if err != nil {
+		return nil, err
+	}
+	out, err := e.Backend.CallContract(ctx, ethereum.CallMsg{To: &GasPriceOracleAddress, Data: input}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return gasPriceOracle.Unpack(method, out)
+}
+
+// L1Fee returns the L1 data fee for the given unsigned transaction data.
+func (e *OPStackL1FeeEstimator) L1Fee(ctx context.Context, data []byte) (*big.Int, error) {
+	out, err := e.call(ctx, "getL1Fee", data)
+	if err != nil {
+		return nil, err
+	}
+	return out[0].(*big.Int), nil
+}
+
+// L1FeeUpperBound returns the Fjord upper bound on the L1 data fee for a
+// transaction of the given unsigned size.
+func (e *OPStackL1FeeEstimator) L1FeeUpperBound(ctx context.Context, size int) (*big.Int, error) {
+	out, err := e.call(ctx, "getL1FeeUpperBound", big.NewInt(int64(size)))
+	if err != nil {
+		return nil, err
+	}
+	return out[0].(*big.Int), nil
+}
+
+// UserOperationL1Fee returns the L1 data fee attributable to op's share of a
+// bundle's calldata.
+func (e *OPStackL1FeeEstimator) UserOperationL1Fee(ctx context.Context, op *UserOperation) (*big.Int, error) {
+	packed, err := op.Pack()
+	if err != nil {
+		return nil, err
+	}
+	return e.L1Fee(ctx, packed)
+}
+
+// EcotoneParams reads the current Ecotone fee parameters from the oracle.
+func (e *OPStackL1FeeEstimator) EcotoneParams(ctx context.Context) (*EcotoneFeeParams, error) {
+	var p EcotoneFeeParams
+	out, err := e.call(ctx, "l1BaseFee")
+	if err != nil {
+		return nil, err
+	}
+	p.L1BaseFee = out[0].(*big.Int)
+	if out, err = e.call(ctx, "blobBaseFee"); err != nil {
+		return nil, err
+	}
+	p.BlobBaseFee = out[0].(*big.Int)
+	if out, err = e.call(ctx, "baseFeeScalar"); err != nil {
+		return nil, err
+	}
+	p.BaseFeeScalar = out[0].(uint32)
+	if out, err = e.call(ctx, "blobBaseFeeScalar"); err != nil {
+		return nil, err
+	}
+	p.BlobBaseFeeScalar = out[0].(uint32)
+	return &p, nil
+}
@@ -0,0 +1,135 @@
+// Package p2p is an EXPERIMENTAL subscriber to the ERC-4337 shared mempool
+// gossip network. It joins the gossipsub topic of one or more mempools and
+// decodes the ops received from peers, for monitoring op propagation.
+//
+// The caller supplies the libp2p host, configured with whatever transports
+// and identity it needs, and is responsible for connecting it to peers. The
+// wire format follows the bundler p2p spec and may change with it.
+package p2p
+
+import (
+	"context"
+	"crypto/sha256"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/snappy"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/peer"
+	bundler_client "github.com/mdehoog/go-bundler-client"
+)
+
+// Message ids are the truncated sha256 of the decompressed payload, under a
+// domain telling apart payloads that fail to decompress.
+var (
+	messageDomainInvalidSnappy = []byte{0x00, 0x00, 0x00, 0x00}
+	messageDomainValidSnappy   = []byte{0x01, 0x00, 0x00, 0x00}
+)
+
+// Topic returns the gossip topic carrying verified ops for mempoolId.
+func Topic(mempoolId string) string {
+	return "/account_abstraction/" + mempoolId + "/verified_user_operation/ssz_snappy"
+}
+
+// Message is an op received from the gossip network.
+type Message struct {
+	MempoolId           string
+	From                peer.ID
+	ReceivedAt          time.Time
+	UserOperation       *bundler_client.UserOperation
+	EntryPoint          common.Address
+	VerifiedAtBlockHash common.Hash
+}
+
+type Listener struct {
+	host host.Host
+	ps   *pubsub.PubSub
+}
+
+// NewListener starts gossipsub on h.
+func NewListener(ctx context.Context, h host.Host) (*Listener, error) {
+	ps, err := pubsub.NewGossipSub(ctx, h,
+		pubsub.WithMessageSignaturePolicy(pubsub.StrictNoSign),
+		pubsub.WithNoAuthor(),
+		pubsub.WithMessageIdFn(messageId),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &Listener{host: h, ps: ps}, nil
+}
+
+// Connect dials the given peers, e.g. the bootnodes of a mempool.
+func (l *Listener) Connect(ctx context.Context, peers ...peer.AddrInfo) error {
+	for _, p := range peers {
+		if err := l.host.Connect(ctx, p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Subscribe joins the topic for mempoolId and delivers decoded ops on the
+// returned channel until ctx is done. Messages that fail to decode are
+// dropped.
+func (l *Listener) Subscribe(ctx context.Context, mempoolId string) (<-chan *Message, error) {
+	topic, err := l.ps.Join(Topic(mempoolId))
+	if err != nil {
+		return nil, err
+	}
+	sub, err := topic.Subscribe()
+	if err != nil {
+		_ = topic.Close()
+		return nil, err
+	}
+	out := make(chan *Message)
+	go func() {
+		defer close(out)
+		defer topic.Close()
+		defer sub.Cancel()
+		for {
+			msg, err := sub.Next(ctx)
+			if err != nil {
+				return
+			}
+			if msg.ReceivedFrom == l.host.ID() {
+				continue
+			}
+			m, err := DecodeMessage(msg.Data)
+			if err != nil {
+				continue
+			}
+			m.MempoolId = mempoolId
+			m.From = msg.ReceivedFrom
+			m.ReceivedAt = time.Now()
+			select {
+			case out <- m:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, nil
+}
+
+// DecodeMessage decodes a snappy-compressed, SSZ-encoded
+// VerifiedUserOperation gossip payload.
+func DecodeMessage(data []byte) (*Message, error) {
+	raw, err := snappy.Decode(nil, data)
+	if err != nil {
+		return nil, err
+	}
+	return decodeVerifiedUserOperation(raw)
+}
+
+func messageId(msg *pb.Message) string {
+	domain := messageDomainValidSnappy
+	data, err := snappy.Decode(nil, msg.Data)
+	if err != nil {
+		domain, data = messageDomainInvalidSnappy, msg.Data
+	}
+	h := sha256.Sum256(append(append([]byte{}, domain...), data...))
+	return string(h[:20])
+}
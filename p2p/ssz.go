@@ -0,0 +1,116 @@
+package p2p
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	bundler_client "github.com/mdehoog/go-bundler-client"
+)
+
+var ErrInvalidSSZ = errors.New("invalid ssz encoding")
+
+const (
+	// fixed part of VerifiedUserOperation: user_operation offset,
+	// entry_point, verified_at_block_hash
+	verifiedOpFixedSize = 4 + common.AddressLength + 32
+	// fixed part of UserOp: sender, nonce, 2 offsets, 5 gas fields, 2
+	// offsets
+	userOpFixedSize = common.AddressLength + 32 + 4 + 4 + 5*32 + 4 + 4
+)
+
+type sszReader struct {
+	data []byte
+	pos  int
+	err  error
+}
+
+func (r *sszReader) next(n int) []byte {
+	if r.err != nil || r.pos+n > len(r.data) {
+		r.err = ErrInvalidSSZ
+		return make([]byte, n)
+	}
+	b := r.data[r.pos : r.pos+n]
+	r.pos += n
+	return b
+}
+
+func (r *sszReader) address() common.Address {
+	return common.BytesToAddress(r.next(common.AddressLength))
+}
+
+// uint256 reads a little-endian uint256.
+func (r *sszReader) uint256() *big.Int {
+	le := r.next(32)
+	be := make([]byte, 32)
+	for i := range le {
+		be[31-i] = le[i]
+	}
+	return new(big.Int).SetBytes(be)
+}
+
+func (r *sszReader) offset() int {
+	return int(binary.LittleEndian.Uint32(r.next(4)))
+}
+
+// variable slices the variable-size fields of a container at the given
+// offsets, each ending where the next begins.
+func variable(data []byte, fixedSize int, offsets ...int) ([][]byte, error) {
+	fields := make([][]byte, len(offsets))
+	for i, start := range offsets {
+		end := len(data)
+		if i+1 < len(offsets) {
+			end = offsets[i+1]
+		}
+		if (i == 0 && start != fixedSize) || start > end || end > len(data) {
+			return nil, ErrInvalidSSZ
+		}
+		fields[i] = common.CopyBytes(data[start:end])
+	}
+	return fields, nil
+}
+
+func decodeVerifiedUserOperation(data []byte) (*Message, error) {
+	r := &sszReader{data: data}
+	opOffset := r.offset()
+	m := &Message{
+		EntryPoint:          r.address(),
+		VerifiedAtBlockHash: common.BigToHash(r.uint256()),
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	fields, err := variable(data, verifiedOpFixedSize, opOffset)
+	if err != nil {
+		return nil, err
+	}
+	if m.UserOperation, err = decodeUserOp(fields[0]); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func decodeUserOp(data []byte) (*bundler_client.UserOperation, error) {
+	r := &sszReader{data: data}
+	op := &bundler_client.UserOperation{
+		Sender: r.address(),
+		Nonce:  r.uint256(),
+	}
+	initCode, callData := r.offset(), r.offset()
+	op.CallGasLimit = r.uint256()
+	op.VerificationGasLimit = r.uint256()
+	op.PreVerificationGas = r.uint256()
+	op.MaxFeePerGas = r.uint256()
+	op.MaxPriorityFeePerGas = r.uint256()
+	paymasterAndData, signature := r.offset(), r.offset()
+	if r.err != nil {
+		return nil, r.err
+	}
+	fields, err := variable(data, userOpFixedSize, initCode, callData, paymasterAndData, signature)
+	if err != nil {
+		return nil, err
+	}
+	op.InitCode, op.CallData, op.PaymasterAndData, op.Signature = fields[0], fields[1], fields[2], fields[3]
+	return op, nil
+}
@@ -0,0 +1,160 @@
+package bundler_client
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// PaymasterClient is the ERC-7677 paymaster web service API. The context is
+// paymaster-specific (e.g. a sponsorship policy id) and must be the same for
+// both calls of a flow; see PaymasterSession.
+type PaymasterClient interface {
+	GetPaymasterStubData(ctx context.Context, op *UserOperation, entryPoint common.Address, chainId *big.Int, pmContext map[string]interface{}) (*PaymasterStubData, error)
+	GetPaymasterData(ctx context.Context, op *UserOperation, entryPoint common.Address, chainId *big.Int, pmContext map[string]interface{}) (*PaymasterData, error)
+}
+
+// PaymasterData is the paymaster's contribution to an op. v0.6 paymasters
+// return PaymasterAndData; v0.7 paymasters return Paymaster and
+// PaymasterData separately.
+type PaymasterData struct {
+	Paymaster        *common.Address `json:"paymaster,omitempty"`
+	PaymasterData    hexutil.Bytes   `json:"paymasterData,omitempty"`
+	PaymasterAndData hexutil.Bytes   `json:"paymasterAndData,omitempty"`
+}
+
+// Bytes returns the v0.6 paymasterAndData field.
+func (d *PaymasterData) Bytes() []byte {
+	if d.PaymasterAndData != nil || d.Paymaster == nil {
+		return d.PaymasterAndData
+	}
+	return append(d.Paymaster.Bytes(), d.PaymasterData...)
+}
+
+type PaymasterSponsor struct {
+	Name string `json:"name"`
+	Icon string `json:"icon,omitempty"`
+}
+
+// PaymasterStubData is paymaster data suitable for gas estimation. If
+// IsFinal is set it may also be used for signing, and pm_getPaymasterData
+// need not be called.
+type PaymasterStubData struct {
+	PaymasterData
+	PaymasterVerificationGasLimit *hexutil.Big      `json:"paymasterVerificationGasLimit,omitempty"`
+	PaymasterPostOpGasLimit       *hexutil.Big      `json:"paymasterPostOpGasLimit,omitempty"`
+	Sponsor                       *PaymasterSponsor `json:"sponsor,omitempty"`
+	IsFinal                       bool              `json:"isFinal,omitempty"`
+}
+
+// DialPaymaster connects to an ERC-7677 paymaster service.
+func DialPaymaster(ctx context.Context, rawurl string, opts ...DialOption) (PaymasterClient, error) {
+	c, err := DialContext(ctx, rawurl, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return c.(*RpcClient), nil
+}
+
+func (c *RpcClient) GetPaymasterStubData(ctx context.Context, op *UserOperation, entryPoint common.Address, chainId *big.Int, pmContext map[string]interface{}) (*PaymasterStubData, error) {
+	var result PaymasterStubData
+	err := c.call(ctx, &result, "pm_getPaymasterStubData", op, entryPoint, (*hexutil.Big)(chainId), pmContext)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *RpcClient) GetPaymasterData(ctx context.Context, op *UserOperation, entryPoint common.Address, chainId *big.Int, pmContext map[string]interface{}) (*PaymasterData, error) {
+	var result PaymasterData
+	err := c.call(ctx, &result, "pm_getPaymasterData", op, entryPoint, (*hexutil.Big)(chainId), pmContext)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// PaymasterSession runs the ERC-7677 flow for one op, carrying the context
+// from the stub data call to the final data call:
+//
+//	s := &PaymasterSession{Client: pm, EntryPoint: ep, ChainId: chainId, Context: policy}
+//	s.Stub(ctx, op)    // before gas estimation
+//	s.Final(ctx, op)   // after estimation, before signing
+type PaymasterSession struct {
+	Client     PaymasterClient
+	EntryPoint common.Address
+	ChainId    *big.Int
+	Context    map[string]interface{}
+
+	final bool
+}
+
+// Stub patches stub paymaster data into op's paymasterAndData.
+func (s *PaymasterSession) Stub(ctx context.Context, op *UserOperation) (*PaymasterStubData, error) {
+	stub, err := s.Client.GetPaymasterStubData(ctx, op, s.EntryPoint, s.ChainId, s.Context)
+	if err != nil {
+		return nil, err
+	}
+	op.PaymasterAndData = stub.Bytes()
+	s.final = stub.IsFinal
+	return stub, nil
+}
+
+// Final patches the final paymaster data into op's paymasterAndData. It is a
+// no-op if the stub data was already final.
+func (s *PaymasterSession) Final(ctx context.Context, op *UserOperation) error {
+	if s.final {
+		return nil
+	}
+	data, err := s.Client.GetPaymasterData(ctx, op, s.EntryPoint, s.ChainId, s.Context)
+	if err != nil {
+		return err
+	}
+	op.PaymasterAndData = data.Bytes()
+	return nil
+}
+
+// SponsorResult is the result of pm_sponsorUserOperation. Gas fields the
+// paymaster does not return are nil.
+type SponsorResult struct {
+	PaymasterData
+	PreVerificationGas            *hexutil.Big `json:"preVerificationGas,omitempty"`
+	VerificationGasLimit          *hexutil.Big `json:"verificationGasLimit,omitempty"`
+	CallGasLimit                  *hexutil.Big `json:"callGasLimit,omitempty"`
+	PaymasterVerificationGasLimit *hexutil.Big `json:"paymasterVerificationGasLimit,omitempty"`
+	PaymasterPostOpGasLimit       *hexutil.Big `json:"paymasterPostOpGasLimit,omitempty"`
+}
+
+// Apply patches the paymaster data and returned gas limits into op.
+func (r *SponsorResult) Apply(op *UserOperation) {
+	op.PaymasterAndData = r.Bytes()
+	if r.PreVerificationGas != nil {
+		op.PreVerificationGas = r.PreVerificationGas.ToInt()
+	}
+	if r.VerificationGasLimit != nil {
+		op.VerificationGasLimit = r.VerificationGasLimit.ToInt()
+	}
+	if r.CallGasLimit != nil {
+		op.CallGasLimit = r.CallGasLimit.ToInt()
+	}
+}
+
+// SponsorUserOperation calls the non-spec pm_sponsorUserOperation method of
+// Stackup, Pimlico and ZeroDev paymaster services, and patches the result
+// into op, which must then be re-signed. pmContext carries the sponsorship
+// policy (e.g. {"type": "payg"} or {"sponsorshipPolicyId": "..."}) and is
+// omitted if nil.
+func (c *RpcClient) SponsorUserOperation(ctx context.Context, op *UserOperation, entryPoint common.Address, pmContext map[string]interface{}) (*SponsorResult, error) {
+	args := []interface{}{op, entryPoint}
+	if pmContext != nil {
+		args = append(args, pmContext)
+	}
+	var result SponsorResult
+	if err := c.call(ctx, &result, "pm_sponsorUserOperation", args...); err != nil {
+		return nil, err
+	}
+	result.Apply(op)
+	return &result, nil
+}
@@ -0,0 +1,145 @@
+package bundler_client
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Layout of the paymaster fields used by verifying paymasters such as the
+// eth-infinitism VerifyingPaymaster: abi.encode(uint48 validUntil, uint48
+// validAfter) followed by the paymaster's signature.
+const (
+	paymasterValidityLength = 64
+	// paymasterV07StaticLength is the paymaster address and its two gas
+	// limits at the start of a v0.7 paymasterAndData.
+	paymasterV07StaticLength = common.AddressLength + 32
+	maxUint48                = 1<<48 - 1
+)
+
+var ErrPaymasterDataTooShort = errors.New("paymaster data too short for validity range")
+
+// VerifyingPaymasterData is the content of a verifying paymaster's
+// paymasterAndData. The gas limits are only set for v0.7 ops. ValidUntil
+// and ValidAfter are unix timestamps; a ValidUntil of zero never expires.
+type VerifyingPaymasterData struct {
+	Paymaster            common.Address
+	VerificationGasLimit *big.Int
+	PostOpGasLimit       *big.Int
+	ValidUntil           uint64
+	ValidAfter           uint64
+	Signature            []byte
+}
+
+// ValidAt reports whether the sponsorship is valid at t.
+func (d *VerifyingPaymasterData) ValidAt(t time.Time) bool {
+	return validAt(d.ValidAfter, d.ValidUntil, t)
+}
+
+// ExpiresWithin reports whether the sponsorship expires before t+dur.
+func (d *VerifyingPaymasterData) ExpiresWithin(t time.Time, dur time.Duration) bool {
+	return d.ValidUntil != 0 && d.ValidUntil < uint64(t.Add(dur).Unix())
+}
+
+// PackPaymasterValidity returns abi.encode(validUntil, validAfter) followed by
+// signature, the paymasterData of a verifying paymaster.
+func PackPaymasterValidity(validUntil, validAfter uint64, signature []byte) ([]byte, error) {
+	if validUntil > maxUint48 || validAfter > maxUint48 {
+		return nil, fmt.Errorf("validity range %d-%d does not fit in uint48", validAfter, validUntil)
+	}
+	data := make([]byte, paymasterValidityLength, paymasterValidityLength+len(signature))
+	new(big.Int).SetUint64(validUntil).FillBytes(data[:32])
+	new(big.Int).SetUint64(validAfter).FillBytes(data[32:64])
+	return append(data, signature...), nil
+}
+
+func unpackPaymasterValidity(data []byte, d *VerifyingPaymasterData) error {
+	if len(data) < paymasterValidityLength {
+		return ErrPaymasterDataTooShort
+	}
+	validUntil := new(big.Int).SetBytes(data[:32])
+	validAfter := new(big.Int).SetBytes(data[32:64])
+	if validUntil.BitLen() > 48 || validAfter.BitLen() > 48 {
+		return fmt.Errorf("validity range %s-%s does not fit in uint48", validAfter, validUntil)
+	}
+	d.ValidUntil, d.ValidAfter = validUntil.Uint64(), validAfter.Uint64()
+	d.Signature = data[paymasterValidityLength:]
+	return nil
+}
+
+// PackPaymasterAndDataV06 returns the v0.6 paymasterAndData of a verifying
+// paymaster.
+func PackPaymasterAndDataV06(paymaster common.Address, validUntil, validAfter uint64, signature []byte) ([]byte, error) {
+	data, err := PackPaymasterValidity(validUntil, validAfter, signature)
+	if err != nil {
+		return nil, err
+	}
+	return append(paymaster.Bytes(), data...), nil
+}
+
+// UnpackPaymasterAndDataV06 parses the v0.6 paymasterAndData of a verifying
+// paymaster.
+func UnpackPaymasterAndDataV06(paymasterAndData []byte) (*VerifyingPaymasterData, error) {
+	if len(paymasterAndData) < common.AddressLength {
+		return nil, ErrPaymasterDataTooShort
+	}
+	d := &VerifyingPaymasterData{Paymaster: common.BytesToAddress(paymasterAndData[:common.AddressLength])}
+	if err := unpackPaymasterValidity(paymasterAndData[common.AddressLength:], d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// PackPaymasterAndDataV07 returns the v0.7 paymasterAndData of a verifying
+// paymaster: the paymaster, its verification and postOp gas limits, and the
+// validity range and signature as paymasterData.
+func PackPaymasterAndDataV07(paymaster common.Address, verificationGasLimit, postOpGasLimit *big.Int, validUntil, validAfter uint64, signature []byte) ([]byte, error) {
+	limits, err := packUint128s(verificationGasLimit, postOpGasLimit)
+	if err != nil {
+		return nil, err
+	}
+	data, err := PackPaymasterValidity(validUntil, validAfter, signature)
+	if err != nil {
+		return nil, err
+	}
+	out := append(paymaster.Bytes(), limits[:]...)
+	return append(out, data...), nil
+}
+
+// UnpackPaymasterAndDataV07 parses the v0.7 paymasterAndData of a verifying
+// paymaster.
+func UnpackPaymasterAndDataV07(paymasterAndData []byte) (*VerifyingPaymasterData, error) {
+	if len(paymasterAndData) < paymasterV07StaticLength {
+		return nil, ErrPaymasterDataTooShort
+	}
+	d := &VerifyingPaymasterData{
+		Paymaster:            common.BytesToAddress(paymasterAndData[:common.AddressLength]),
+		VerificationGasLimit: new(big.Int).SetBytes(paymasterAndData[common.AddressLength : common.AddressLength+16]),
+		PostOpGasLimit:       new(big.Int).SetBytes(paymasterAndData[common.AddressLength+16 : paymasterV07StaticLength]),
+	}
+	if err := unpackPaymasterValidity(paymasterAndData[paymasterV07StaticLength:], d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// ExpiringSponsorships returns the ops among a v0.6 mempool dump whose
+// verifying paymaster sponsorship expires within dur of now, for spotting
+// ops that will be rejected before they are bundled. Ops without a paymaster
+// or with paymaster data in another layout are skipped.
+func ExpiringSponsorships(ops []*UserOperation, now time.Time, dur time.Duration) map[*UserOperation]*VerifyingPaymasterData {
+	expiring := make(map[*UserOperation]*VerifyingPaymasterData)
+	for _, op := range ops {
+		d, err := UnpackPaymasterAndDataV06(op.PaymasterAndData)
+		if err != nil {
+			continue
+		}
+		if d.ExpiresWithin(now, dur) {
+			expiring[op] = d
+		}
+	}
+	return expiring
+}
@@ -0,0 +1,87 @@
+package bundler_client
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// PimlicoClient exposes the pimlico_* extension methods of Pimlico bundlers
+// (Alto).
+type PimlicoClient struct {
+	c *RpcClient
+}
+
+// Pimlico returns the Pimlico extension client for c.
+func (c *RpcClient) Pimlico() *PimlicoClient {
+	return &PimlicoClient{c: c}
+}
+
+// GasPrice is a pair of EIP-1559 fees for an op.
+type GasPrice struct {
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+}
+
+func (p *GasPrice) UnmarshalJSON(input []byte) error {
+	var dec struct {
+		MaxFeePerGas         TolerantBig `json:"maxFeePerGas"`
+		MaxPriorityFeePerGas TolerantBig `json:"maxPriorityFeePerGas"`
+	}
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	p.MaxFeePerGas = dec.MaxFeePerGas.ToInt()
+	p.MaxPriorityFeePerGas = dec.MaxPriorityFeePerGas.ToInt()
+	return nil
+}
+
+// GasPriceTiers are the gas prices suggested by pimlico_getUserOperationGasPrice.
+type GasPriceTiers struct {
+	Slow     GasPrice `json:"slow"`
+	Standard GasPrice `json:"standard"`
+	Fast     GasPrice `json:"fast"`
+}
+
+func (c *PimlicoClient) GetUserOperationGasPrice(ctx context.Context) (*GasPriceTiers, error) {
+	var tiers GasPriceTiers
+	err := c.c.call(ctx, &tiers, "pimlico_getUserOperationGasPrice", []interface{}{}...)
+	if err != nil {
+		return nil, err
+	}
+	return &tiers, nil
+}
+
+// PimlicoStatus is the result of pimlico_getUserOperationStatus. Status is
+// one of "not_found", "not_submitted", "submitted", "rejected", "reverted",
+// "included" or "failed".
+type PimlicoStatus struct {
+	Status          string       `json:"status"`
+	TransactionHash *common.Hash `json:"transactionHash"`
+}
+
+// OpStatus maps the Pimlico status onto OpStatus.
+func (s *PimlicoStatus) OpStatus() OpStatus {
+	switch s.Status {
+	case "not_submitted", "submitted":
+		return OpStatusPending
+	case "included":
+		return OpStatusIncluded
+	case "reverted", "failed":
+		return OpStatusReverted
+	case "rejected":
+		return OpStatusDropped
+	}
+	return OpStatusUnknown
+}
+
+func (c *PimlicoClient) GetUserOperationStatus(ctx context.Context, userOpHash common.Hash) (*PimlicoStatus, error) {
+	var status PimlicoStatus
+	err := c.c.call(ctx, &status, "pimlico_getUserOperationStatus", userOpHash)
+	if err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
@@ -0,0 +1,161 @@
+package bundler_client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"golang.org/x/time/rate"
+)
+
+var ErrPolicyRejected = errors.New("rejected by policy")
+
+// PolicyError is returned when a client-side policy rejects a user operation
+// before it is sent. It matches ErrPolicyRejected with errors.Is.
+type PolicyError struct {
+	Policy string
+	Reason string
+}
+
+func (e *PolicyError) Error() string {
+	return fmt.Sprintf("%s policy: %s", e.Policy, e.Reason)
+}
+
+func (e *PolicyError) Is(target error) bool {
+	return target == ErrPolicyRejected
+}
+
+// Policy is invoked before every send and may reject the op by returning an
+// error.
+type Policy interface {
+	Check(ctx context.Context, op *UserOperation, entryPoint common.Address) error
+}
+
+type PolicyFunc func(ctx context.Context, op *UserOperation, entryPoint common.Address) error
+
+func (f PolicyFunc) Check(ctx context.Context, op *UserOperation, entryPoint common.Address) error {
+	return f(ctx, op, entryPoint)
+}
+
+// PaymasterPolicy restricts which paymasters ops may use. Denied paymasters
+// are always rejected; if Allowed is non-empty, only those paymasters are
+// accepted. Unsponsored ops are accepted unless RequirePaymaster is set.
+type PaymasterPolicy struct {
+	Allowed          map[common.Address]bool
+	Denied           map[common.Address]bool
+	RequirePaymaster bool
+}
+
+func (p *PaymasterPolicy) Check(_ context.Context, op *UserOperation, _ common.Address) error {
+	paymaster := op.GetPaymaster()
+	if paymaster == (common.Address{}) {
+		if p.RequirePaymaster {
+			return &PolicyError{Policy: "paymaster", Reason: "op is not sponsored"}
+		}
+		return nil
+	}
+	if p.Denied[paymaster] {
+		return &PolicyError{Policy: "paymaster", Reason: fmt.Sprintf("paymaster %s is denied", paymaster)}
+	}
+	if len(p.Allowed) > 0 && !p.Allowed[paymaster] {
+		return &PolicyError{Policy: "paymaster", Reason: fmt.Sprintf("paymaster %s is not allowed", paymaster)}
+	}
+	return nil
+}
+
+type policyClient struct {
+	Client
+	policies []Policy
+}
+
+// NewPolicyClient wraps c so that every policy is checked, in order, before
+// an op is sent.
+func NewPolicyClient(c Client, policies ...Policy) Client {
+	return &policyClient{Client: c, policies: policies}
+}
+
+func (c *policyClient) SendUserOperation(ctx context.Context, op *UserOperation, entryPoint common.Address) (common.Hash, error) {
+	for _, p := range c.policies {
+		if err := p.Check(ctx, op, entryPoint); err != nil {
+			return common.Hash{}, err
+		}
+	}
+	return c.Client.SendUserOperation(ctx, op, entryPoint)
+}
+
+// SenderPolicy restricts which senders may submit ops, for multi-tenant
+// relayers exposed to end users. Denied senders are always rejected; if
+// Allowed is non-empty, only those senders are accepted. If Limit is set,
+// each sender is additionally rate limited to Limit ops per second with the
+// given Burst. The limiters of senders idle long enough to refill their
+// burst are dropped, so memory is bounded by the number of recently active
+// senders.
+type SenderPolicy struct {
+	Allowed map[common.Address]bool
+	Denied  map[common.Address]bool
+	Limit   rate.Limit
+	Burst   int
+
+	mu       sync.Mutex
+	limiters map[common.Address]*senderLimiter
+	sweepAt  int
+}
+
+type senderLimiter struct {
+	*rate.Limiter
+	lastUsed time.Time
+}
+
+// minSenderSweep is the number of limiters below which idle ones are kept.
+const minSenderSweep = 1024
+
+func (p *SenderPolicy) Check(_ context.Context, op *UserOperation, _ common.Address) error {
+	if p.Denied[op.Sender] {
+		return &PolicyError{Policy: "sender", Reason: fmt.Sprintf("sender %s is denied", op.Sender)}
+	}
+	if len(p.Allowed) > 0 && !p.Allowed[op.Sender] {
+		return &PolicyError{Policy: "sender", Reason: fmt.Sprintf("sender %s is not allowed", op.Sender)}
+	}
+	if p.Limit > 0 && !p.limiter(op.Sender).Allow() {
+		return &PolicyError{Policy: "sender", Reason: fmt.Sprintf("sender %s exceeded rate limit", op.Sender)}
+	}
+	return nil
+}
+
+func (p *SenderPolicy) limiter(sender common.Address) *rate.Limiter {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := time.Now()
+	if p.limiters == nil {
+		p.limiters = make(map[common.Address]*senderLimiter)
+	}
+	l, ok := p.limiters[sender]
+	if !ok {
+		if len(p.limiters) >= p.sweepAt {
+			p.sweep(now)
+		}
+		l = &senderLimiter{Limiter: rate.NewLimiter(p.Limit, p.Burst)}
+		p.limiters[sender] = l
+	}
+	l.lastUsed = now
+	return l.Limiter
+}
+
+// sweep drops the limiters that have been idle for long enough to be full
+// again, which makes them indistinguishable from new ones. Sweeping again
+// only once the map has doubled keeps the cost amortized constant.
+func (p *SenderPolicy) sweep(now time.Time) {
+	refill := time.Duration(float64(p.Burst) / float64(p.Limit) * float64(time.Second))
+	for sender, l := range p.limiters {
+		if now.Sub(l.lastUsed) >= refill {
+			delete(p.limiters, sender)
+		}
+	}
+	p.sweepAt = 2 * len(p.limiters)
+	if p.sweepAt < minSenderSweep {
+		p.sweepAt = minSenderSweep
+	}
+}
@@ -0,0 +1,257 @@
+package bundler_client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const entryPointV06SimulationABI = `[
+	{"type":"function","name":"simulateValidation","inputs":[{"name":"userOp","type":"tuple","components":[
+		{"name":"sender","type":"address"},{"name":"nonce","type":"uint256"},{"name":"initCode","type":"bytes"},{"name":"callData","type":"bytes"},
+		{"name":"callGasLimit","type":"uint256"},{"name":"verificationGasLimit","type":"uint256"},{"name":"preVerificationGas","type":"uint256"},
+		{"name":"maxFeePerGas","type":"uint256"},{"name":"maxPriorityFeePerGas","type":"uint256"},{"name":"paymasterAndData","type":"bytes"},{"name":"signature","type":"bytes"}]}],"outputs":[]},
+	{"type":"error","name":"ValidationResult","inputs":[
+		{"name":"returnInfo","type":"tuple","components":[{"name":"preOpGas","type":"uint256"},{"name":"prefund","type":"uint256"},{"name":"sigFailed","type":"bool"},{"name":"validAfter","type":"uint48"},{"name":"validUntil","type":"uint48"},{"name":"paymasterContext","type":"bytes"}]},
+		{"name":"senderInfo","type":"tuple","components":[{"name":"stake","type":"uint256"},{"name":"unstakeDelaySec","type":"uint256"}]},
+		{"name":"factoryInfo","type":"tuple","components":[{"name":"stake","type":"uint256"},{"name":"unstakeDelaySec","type":"uint256"}]},
+		{"name":"paymasterInfo","type":"tuple","components":[{"name":"stake","type":"uint256"},{"name":"unstakeDelaySec","type":"uint256"}]}]},
+	{"type":"error","name":"ValidationResultWithAggregation","inputs":[
+		{"name":"returnInfo","type":"tuple","components":[{"name":"preOpGas","type":"uint256"},{"name":"prefund","type":"uint256"},{"name":"sigFailed","type":"bool"},{"name":"validAfter","type":"uint48"},{"name":"validUntil","type":"uint48"},{"name":"paymasterContext","type":"bytes"}]},
+		{"name":"senderInfo","type":"tuple","components":[{"name":"stake","type":"uint256"},{"name":"unstakeDelaySec","type":"uint256"}]},
+		{"name":"factoryInfo","type":"tuple","components":[{"name":"stake","type":"uint256"},{"name":"unstakeDelaySec","type":"uint256"}]},
+		{"name":"paymasterInfo","type":"tuple","components":[{"name":"stake","type":"uint256"},{"name":"unstakeDelaySec","type":"uint256"}]},
+		{"name":"aggregatorInfo","type":"tuple","components":[{"name":"aggregator","type":"address"},{"name":"stakeInfo","type":"tuple","components":[{"name":"stake","type":"uint256"},{"name":"unstakeDelaySec","type":"uint256"}]}]}]},
+	{"type":"error","name":"FailedOp","inputs":[{"name":"opIndex","type":"uint256"},{"name":"reason","type":"string"}]}
+]`
+
+// methodNotFound is the JSON-RPC error code for unsupported methods.
+const methodNotFound = -32601
+
+var entryPointV06Simulation, _ = abi.JSON(strings.NewReader(entryPointV06SimulationABI))
+
+var ErrUnexpectedSimulationResult = errors.New("simulateValidation did not revert with a known result")
+
+// ValidationResult is the outcome of validating an op without adding it to
+// the mempool.
+type ValidationResult struct {
+	PreOpGas         *big.Int
+	Prefund          *big.Int
+	SigFailed        bool
+	ValidAfter       uint64
+	ValidUntil       uint64
+	PaymasterContext []byte
+}
+
+const failedOpABI = `[
+	{"type":"error","name":"FailedOp","inputs":[{"name":"opIndex","type":"uint256"},{"name":"reason","type":"string"}]},
+	{"type":"error","name":"FailedOpWithRevert","inputs":[{"name":"opIndex","type":"uint256"},{"name":"reason","type":"string"},{"name":"inner","type":"bytes"}]}
+]`
+
+var failedOpErrors, _ = abi.JSON(strings.NewReader(failedOpABI))
+
+// FailedOpError is the EntryPoint's FailedOp revert, carrying the AAxx
+// reason the op failed validation with. Inner is the revert data of the
+// account or paymaster, set for the FailedOpWithRevert revert of v0.7.
+type FailedOpError struct {
+	OpIndex *big.Int
+	Reason  string
+	Inner   []byte
+}
+
+func (e *FailedOpError) Error() string {
+	if e.Inner != nil {
+		return fmt.Sprintf("FailedOpWithRevert(%d, %q, %s)", e.OpIndex, e.Reason, e.InnerReason())
+	}
+	return fmt.Sprintf("FailedOp(%d, %q)", e.OpIndex, e.Reason)
+}
+
+// InnerReason decodes Inner on a best-effort basis: the message of an
+// Error(string) revert, the code of a Panic(uint256), Inner itself if it is
+// printable ASCII, and its hex encoding otherwise.
+func (e *FailedOpError) InnerReason() string {
+	if reason, err := abi.UnpackRevert(e.Inner); err == nil {
+		return reason
+	}
+	if isPrintableASCII(e.Inner) {
+		return string(e.Inner)
+	}
+	return hexutil.Encode(e.Inner)
+}
+
+func isPrintableASCII(b []byte) bool {
+	for _, c := range b {
+		if c < 0x20 || c > 0x7e {
+			return false
+		}
+	}
+	return len(b) > 0
+}
+
+// DecodeFailedOp decodes a FailedOp or FailedOpWithRevert revert, returning
+// false if data is neither.
+func DecodeFailedOp(data []byte) (*FailedOpError, bool) {
+	if len(data) < 4 {
+		return nil, false
+	}
+	for _, e := range failedOpErrors.Errors {
+		if !bytes.Equal(data[:4], e.ID[:4]) {
+			continue
+		}
+		values, err := e.Inputs.Unpack(data[4:])
+		if err != nil {
+			return nil, false
+		}
+		failed := &FailedOpError{OpIndex: values[0].(*big.Int), Reason: values[1].(string)}
+		if len(values) > 2 {
+			failed.Inner = values[2].([]byte)
+		}
+		return failed, true
+	}
+	return nil, false
+}
+
+// ParseFailedOp decodes the FailedOp revert carried in the data of an RPC
+// error, as returned by eth_call and by bundlers rejecting an op.
+func ParseFailedOp(err error) (*FailedOpError, bool) {
+	data, ok := revertData(err)
+	if !ok {
+		return nil, false
+	}
+	return DecodeFailedOp(data)
+}
+
+// ValidateUserOperation calls the non-spec eth_validateUserOperation method
+// supported by some bundlers (e.g. Skandha), which runs validation without
+// adding the op to the mempool.
+func (c *RpcClient) ValidateUserOperation(ctx context.Context, op *UserOperation, entryPoint common.Address) (*ValidationResult, error) {
+	var result struct {
+		ReturnInfo struct {
+			PreOpGas         TolerantBig   `json:"preOpGas"`
+			Prefund          TolerantBig   `json:"prefund"`
+			SigFailed        bool          `json:"sigFailed"`
+			ValidAfter       TolerantBig   `json:"validAfter"`
+			ValidUntil       TolerantBig   `json:"validUntil"`
+			PaymasterContext hexutil.Bytes `json:"paymasterContext"`
+		} `json:"returnInfo"`
+	}
+	if err := c.call(ctx, &result, "eth_validateUserOperation", op, entryPoint); err != nil {
+		return nil, err
+	}
+	r := result.ReturnInfo
+	return &ValidationResult{
+		PreOpGas:         r.PreOpGas.ToInt(),
+		Prefund:          r.Prefund.ToInt(),
+		SigFailed:        r.SigFailed,
+		ValidAfter:       r.ValidAfter.ToInt().Uint64(),
+		ValidUntil:       r.ValidUntil.ToInt().Uint64(),
+		PaymasterContext: r.PaymasterContext,
+	}, nil
+}
+
+// SimulateValidation eth_calls a v0.6 EntryPoint's simulateValidation, which
+// always reverts, and decodes the revert into a result or a *FailedOpError.
+func SimulateValidation(ctx context.Context, backend ethereum.ContractCaller, op *UserOperation, entryPoint common.Address) (*ValidationResult, error) {
+	input, err := entryPointV06Simulation.Pack("simulateValidation", op)
+	if err != nil {
+		return nil, err
+	}
+	_, err = backend.CallContract(ctx, ethereum.CallMsg{To: &entryPoint, Data: input}, nil)
+	if err == nil {
+		return nil, ErrUnexpectedSimulationResult
+	}
+	data, ok := revertData(err)
+	if !ok {
+		return nil, err
+	}
+	return decodeSimulationRevert(data)
+}
+
+// revertData extracts the revert data of an eth_call error.
+func revertData(err error) ([]byte, bool) {
+	var dataErr rpc.DataError
+	if !errors.As(err, &dataErr) {
+		return nil, false
+	}
+	s, ok := dataErr.ErrorData().(string)
+	if !ok {
+		return nil, false
+	}
+	data, err := hexutil.Decode(s)
+	return data, err == nil
+}
+
+func decodeSimulationRevert(data []byte) (*ValidationResult, error) {
+	if len(data) < 4 {
+		return nil, ErrUnexpectedSimulationResult
+	}
+	for _, e := range entryPointV06Simulation.Errors {
+		if !bytes.Equal(data[:4], e.ID[:4]) {
+			continue
+		}
+		if failed, ok := DecodeFailedOp(data); ok {
+			return nil, failed
+		}
+		values, err := e.Inputs.Unpack(data[4:])
+		if err != nil {
+			return nil, err
+		}
+		var info struct {
+			PreOpGas         *big.Int
+			Prefund          *big.Int
+			SigFailed        bool
+			ValidAfter       *big.Int
+			ValidUntil       *big.Int
+			PaymasterContext []byte
+		}
+		abi.ConvertType(values[0], &info)
+		return &ValidationResult{
+			PreOpGas:         info.PreOpGas,
+			Prefund:          info.Prefund,
+			SigFailed:        info.SigFailed,
+			ValidAfter:       info.ValidAfter.Uint64(),
+			ValidUntil:       info.ValidUntil.Uint64(),
+			PaymasterContext: info.PaymasterContext,
+		}, nil
+	}
+	return nil, ErrUnexpectedSimulationResult
+}
+
+// Preflighter validates ops before sending them. It uses the bundler's
+// eth_validateUserOperation where supported, and otherwise falls back to
+// simulateValidation against Backend, which requires a v0.6 EntryPoint.
+type Preflighter struct {
+	Client     Client
+	Backend    ethereum.ContractCaller
+	EntryPoint common.Address
+
+	unsupported atomic.Bool
+}
+
+func NewPreflighter(c Client, backend ethereum.ContractCaller, entryPoint common.Address) *Preflighter {
+	return &Preflighter{Client: c, Backend: backend, EntryPoint: entryPoint}
+}
+
+func (p *Preflighter) Preflight(ctx context.Context, op *UserOperation) (*ValidationResult, error) {
+	if v, ok := p.Client.(interface {
+		ValidateUserOperation(context.Context, *UserOperation, common.Address) (*ValidationResult, error)
+	}); ok && !p.unsupported.Load() {
+		res, err := v.ValidateUserOperation(ctx, op, p.EntryPoint)
+		var rpcErr rpc.Error
+		if !errors.As(err, &rpcErr) || rpcErr.ErrorCode() != methodNotFound {
+			return res, err
+		}
+		p.unsupported.Store(true)
+	}
+	return SimulateValidation(ctx, p.Backend, op, p.EntryPoint)
+}
@@ -0,0 +1,58 @@
+package bundler_client
+
+import "sync"
+
+// Priority classifies bundler RPC methods so that user-facing calls (sends,
+// receipt confirmation) preempt background reads while waiting on a
+// WithRateLimit limiter.
+type Priority int
+
+const (
+	PriorityBackground Priority = iota
+	PriorityNormal
+	PriorityHigh
+
+	numPriorities = int(PriorityHigh) + 1
+)
+
+func (p Priority) String() string {
+	switch p {
+	case PriorityBackground:
+		return "background"
+	case PriorityNormal:
+		return "normal"
+	case PriorityHigh:
+		return "high"
+	}
+	return "unknown"
+}
+
+var (
+	methodPrioritiesMu sync.RWMutex
+	methodPriorities   = map[string]Priority{
+		"eth_sendUserOperation":         PriorityHigh,
+		"eth_getUserOperationReceipt":   PriorityHigh,
+		"debug_bundler_dumpMempool":     PriorityBackground,
+		"debug_bundler_dumpReputation":  PriorityBackground,
+		"debug_bundler_getStakeStatus":  PriorityBackground,
+		"debug_bundler_getBundlingMode": PriorityBackground,
+	}
+)
+
+// MethodPriority returns the scheduling priority of the given RPC method.
+// Methods without an explicit classification are PriorityNormal.
+func MethodPriority(method string) Priority {
+	methodPrioritiesMu.RLock()
+	defer methodPrioritiesMu.RUnlock()
+	if p, ok := methodPriorities[method]; ok {
+		return p
+	}
+	return PriorityNormal
+}
+
+// SetMethodPriority overrides the scheduling priority of the given RPC method.
+func SetMethodPriority(method string, p Priority) {
+	methodPrioritiesMu.Lock()
+	defer methodPrioritiesMu.Unlock()
+	methodPriorities[method] = p
+}
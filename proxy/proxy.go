@@ -0,0 +1,193 @@
+// Package proxy implements a small HTTP/JSON server wrapping a bundler
+// client, for use as a sidecar between frontends and bundlers.
+//
+//	POST /userops                   {"userOperation": {...}, "entryPoint": "0x..."}
+//	GET  /userops/{hash}
+//	GET  /userops/{hash}/receipt
+package proxy
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+	bundler_client "github.com/mdehoog/go-bundler-client"
+	"golang.org/x/time/rate"
+)
+
+type Config struct {
+	// EntryPoint is used for submissions that do not specify one.
+	EntryPoint common.Address
+	// Tokens, if non-empty, are the accepted bearer tokens. Surrounding
+	// whitespace is ignored and empty tokens never match.
+	Tokens []string
+	// Limit and Burst rate limit all requests; zero Limit disables limiting.
+	Limit rate.Limit
+	Burst int
+}
+
+type Server struct {
+	client  bundler_client.Client
+	cfg     Config
+	tokens  [][]byte
+	limiter *rate.Limiter
+}
+
+func NewServer(client bundler_client.Client, cfg Config) *Server {
+	s := &Server{client: client, cfg: cfg}
+	for _, t := range cfg.Tokens {
+		if t = strings.TrimSpace(t); t != "" {
+			s.tokens = append(s.tokens, []byte(t))
+		}
+	}
+	if cfg.Limit > 0 {
+		s.limiter = rate.NewLimiter(cfg.Limit, cfg.Burst)
+	}
+	return s
+}
+
+type sendRequest struct {
+	UserOperation *bundler_client.UserOperation `json:"userOperation"`
+	EntryPoint    *common.Address               `json:"entryPoint"`
+}
+
+type sendResponse struct {
+	UserOpHash common.Hash `json:"userOpHash"`
+}
+
+type errorResponse struct {
+	Error string      `json:"error"`
+	Code  int         `json:"code,omitempty"`
+	Data  interface{} `json:"data,omitempty"`
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !s.authorized(r) {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		writeError(w, http.StatusUnauthorized, errors.New("unauthorized"))
+		return
+	}
+	if s.limiter != nil && !s.limiter.Allow() {
+		writeError(w, http.StatusTooManyRequests, errors.New("rate limit exceeded"))
+		return
+	}
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case len(parts) == 1 && parts[0] == "userops" && r.Method == http.MethodPost:
+		s.send(w, r)
+	case len(parts) == 2 && parts[0] == "userops" && r.Method == http.MethodGet:
+		s.lookup(w, r, parts[1])
+	case len(parts) == 3 && parts[0] == "userops" && parts[2] == "receipt" && r.Method == http.MethodGet:
+		s.receipt(w, r, parts[1])
+	default:
+		writeError(w, http.StatusNotFound, errors.New("not found"))
+	}
+}
+
+func (s *Server) authorized(r *http.Request) bool {
+	if len(s.cfg.Tokens) == 0 {
+		return true
+	}
+	scheme, token, ok := strings.Cut(strings.TrimSpace(r.Header.Get("Authorization")), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return false
+	}
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return false
+	}
+	for _, t := range s.tokens {
+		if subtle.ConstantTimeCompare([]byte(token), t) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) send(w http.ResponseWriter, r *http.Request) {
+	var req sendRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20)).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if req.UserOperation == nil {
+		writeError(w, http.StatusBadRequest, errors.New("missing userOperation"))
+		return
+	}
+	ep := s.cfg.EntryPoint
+	if req.EntryPoint != nil {
+		ep = *req.EntryPoint
+	}
+	hash, err := s.client.SendUserOperation(r.Context(), req.UserOperation, ep)
+	if err != nil {
+		writeError(w, http.StatusBadGateway, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, sendResponse{UserOpHash: hash})
+}
+
+func parseHash(w http.ResponseWriter, s string) (common.Hash, bool) {
+	if len(strings.TrimPrefix(s, "0x")) != 2*common.HashLength {
+		writeError(w, http.StatusBadRequest, errors.New("invalid userOpHash"))
+		return common.Hash{}, false
+	}
+	return common.HexToHash(s), true
+}
+
+func (s *Server) lookup(w http.ResponseWriter, r *http.Request, h string) {
+	hash, ok := parseHash(w, h)
+	if !ok {
+		return
+	}
+	res, err := s.client.GetUserOperationByHash(r.Context(), hash)
+	if err != nil {
+		writeError(w, http.StatusBadGateway, err)
+		return
+	}
+	if res == nil || res.UserOperation == nil {
+		writeError(w, http.StatusNotFound, errors.New("user operation not found"))
+		return
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (s *Server) receipt(w http.ResponseWriter, r *http.Request, h string) {
+	hash, ok := parseHash(w, h)
+	if !ok {
+		return
+	}
+	res, err := s.client.GetUserOperationReceipt(r.Context(), hash)
+	if err != nil {
+		writeError(w, http.StatusBadGateway, err)
+		return
+	}
+	if res == nil || res.UserOpHash != hash {
+		writeError(w, http.StatusNotFound, errors.New("receipt not found"))
+		return
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	res := errorResponse{Error: err.Error()}
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		res.Code = rpcErr.ErrorCode()
+	}
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		res.Data = dataErr.ErrorData()
+	}
+	writeJSON(w, status, res)
+}
@@ -0,0 +1,172 @@
+package bundler_client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// PVGConfig parameterizes the local preVerificationGas calculation. The
+// defaults mirror the overhead model used by the Stackup bundler.
+type PVGConfig struct {
+	IntrinsicFixed      uint64
+	PerUserOpFixed      uint64
+	PerUserOpMultiplier uint64
+	ZeroByte            uint64
+	NonZeroByte         uint64
+	MinBundleSize       uint64
+
+	// EIP7623 enables the calldata floor pricing introduced in Prague, where
+	// a transaction pays at least FloorPerToken gas per calldata token.
+	EIP7623 bool
+}
+
+const (
+	eip7623StandardPerToken = 4
+	eip7623FloorPerToken    = 10
+)
+
+var DefaultPVGConfig = PVGConfig{
+	IntrinsicFixed:      21000,
+	PerUserOpFixed:      22874,
+	PerUserOpMultiplier: 25,
+	ZeroByte:            4,
+	NonZeroByte:         16,
+	MinBundleSize:       1,
+}
+
+var (
+	sanitizedPVG = big.NewInt(100000)
+	sanitizedVGL = big.NewInt(1000000)
+	sanitizedCGL = big.NewInt(1000000)
+)
+
+// sanitizeForPVG replaces the gas fields and signature of op with fixed-size
+// placeholders so the calculation does not depend on the values being
+// estimated.
+func sanitizeForPVG(op *UserOperation) *UserOperation {
+	tmp := *op
+	tmp.PreVerificationGas = sanitizedPVG
+	tmp.VerificationGasLimit = sanitizedVGL
+	tmp.CallGasLimit = sanitizedCGL
+	tmp.Signature = bytes.Repeat([]byte{1}, len(op.Signature))
+	return &tmp
+}
+
+// calldataTokens counts EIP-7623 calldata tokens: one per zero byte and four
+// per non-zero byte.
+func calldataTokens(data []byte) uint64 {
+	var tokens uint64
+	for _, b := range data {
+		if b == 0 {
+			tokens++
+		} else {
+			tokens += 4
+		}
+	}
+	return tokens
+}
+
+func (cfg PVGConfig) callDataCost(packed []byte, executionGas *big.Int) uint64 {
+	if !cfg.EIP7623 {
+		var cost uint64
+		for _, b := range packed {
+			if b == 0 {
+				cost += cfg.ZeroByte
+			} else {
+				cost += cfg.NonZeroByte
+			}
+		}
+		return cost
+	}
+	// Under EIP-7623 the transaction pays max(standard + execution, floor).
+	// Execution gas is covered by the op's own limits, so PVG only needs to
+	// cover whatever the floor adds on top of them.
+	tokens := calldataTokens(packed)
+	standard := tokens * eip7623StandardPerToken
+	floor := new(big.Int).SetUint64(tokens * eip7623FloorPerToken)
+	if floor.Sub(floor, executionGas).Cmp(new(big.Int).SetUint64(standard)) > 0 {
+		return floor.Uint64()
+	}
+	return standard
+}
+
+// CalcPreVerificationGas returns the preVerificationGas needed to cover the
+// op's share of bundle overhead and calldata cost on L1-priced chains. When
+// EIP7623 is enabled, the op's verificationGasLimit and callGasLimit are
+// treated as its execution gas.
+func (cfg PVGConfig) CalcPreVerificationGas(op *UserOperation) (*big.Int, error) {
+	packed, err := sanitizeForPVG(op).Pack()
+	if err != nil {
+		return nil, err
+	}
+	executionGas := new(big.Int)
+	if op.VerificationGasLimit != nil {
+		executionGas.Add(executionGas, op.VerificationGasLimit)
+	}
+	if op.CallGasLimit != nil {
+		executionGas.Add(executionGas, op.CallGasLimit)
+	}
+
+	minBundleSize := cfg.MinBundleSize
+	if minBundleSize == 0 {
+		minBundleSize = 1
+	}
+	words := uint64(len(packed)+31) / 32
+	pvg := cfg.IntrinsicFixed/minBundleSize +
+		cfg.callDataCost(packed, executionGas) +
+		cfg.PerUserOpMultiplier*words + cfg.PerUserOpFixed
+	return new(big.Int).SetUint64(pvg), nil
+}
+
+// PVGCalculator computes the full preVerificationGas of ops on a chain: the
+// calldata and overhead cost from Config, plus any L1 data component added
+// by the chain's adapter, such as the OP-Stack L1 data fee converted to gas
+// at the op's effective gas price.
+type PVGCalculator struct {
+	Config     PVGConfig
+	Adapter    ChainAdapter
+	EntryPoint common.Address
+}
+
+// NewPVGCalculator returns a calculator for the given chain, eth_calling
+// backend for the L1 component where the chain has one. On OP-Stack chains
+// backend must also be a HeaderReader.
+func NewPVGCalculator(chainId uint64, backend ethereum.ContractCaller, entryPoint common.Address) *PVGCalculator {
+	return &PVGCalculator{Config: DefaultPVGConfig, Adapter: ChainAdapterFor(chainId, backend), EntryPoint: entryPoint}
+}
+
+func (p *PVGCalculator) PreVerificationGas(ctx context.Context, op *UserOperation) (*big.Int, error) {
+	pvg, err := p.Config.CalcPreVerificationGas(op)
+	if err != nil {
+		return nil, err
+	}
+	return p.Adapter.AdjustPreVerificationGas(ctx, op, p.EntryPoint, pvg)
+}
+
+// PVGTooLowError is returned by CheckPreVerificationGas when an op's
+// preVerificationGas is below the locally computed value.
+type PVGTooLowError struct {
+	Have, Want *big.Int
+}
+
+func (e *PVGTooLowError) Error() string {
+	return fmt.Sprintf("preVerificationGas %s below expected %s", e.Have, e.Want)
+}
+
+// CheckPreVerificationGas sanity-checks op's preVerificationGas, typically
+// as estimated by a bundler, against the locally computed value.
+func (p *PVGCalculator) CheckPreVerificationGas(ctx context.Context, op *UserOperation) error {
+	want, err := p.PreVerificationGas(ctx, op)
+	if err != nil {
+		return err
+	}
+	if op.PreVerificationGas == nil || op.PreVerificationGas.Cmp(want) < 0 {
+		return &PVGTooLowError{Have: op.PreVerificationGas, Want: want}
+	}
+	return nil
+}
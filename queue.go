@@ -0,0 +1,385 @@
+package bundler_client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mdehoog/go-bundler-client/aaerrors"
+)
+
+// QueuedOp is a user operation held by a SubmitQueue until its receipt is
+// seen.
+type QueuedOp struct {
+	UserOpHash common.Hash    `json:"userOpHash"`
+	EntryPoint common.Address `json:"entryPoint"`
+	Op         *UserOperation `json:"userOperation"`
+	Attempts   int            `json:"attempts"`
+	LastError  string         `json:"lastError,omitempty"`
+	SentAt     time.Time      `json:"sentAt,omitempty"`
+	CreatedAt  time.Time      `json:"createdAt"`
+}
+
+// QueueStore persists the ops of a SubmitQueue. Save inserts or replaces the
+// op with the same UserOpHash, Load returns all stored ops and Remove drops
+// the op with the given hash.
+type QueueStore interface {
+	Save(op *QueuedOp) error
+	Load() ([]*QueuedOp, error)
+	Remove(userOpHash common.Hash) error
+}
+
+// MemoryQueueStore is a QueueStore that does not survive restarts, for tests
+// and short-lived processes.
+type MemoryQueueStore struct {
+	mu  sync.Mutex
+	ops map[common.Hash]QueuedOp
+}
+
+func NewMemoryQueueStore() *MemoryQueueStore {
+	return &MemoryQueueStore{ops: make(map[common.Hash]QueuedOp)}
+}
+
+func (s *MemoryQueueStore) Save(op *QueuedOp) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ops[op.UserOpHash] = *op
+	return nil
+}
+
+func (s *MemoryQueueStore) Load() ([]*QueuedOp, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ops := make([]*QueuedOp, 0, len(s.ops))
+	for _, op := range s.ops {
+		op := op
+		ops = append(ops, &op)
+	}
+	return ops, nil
+}
+
+func (s *MemoryQueueStore) Remove(userOpHash common.Hash) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.ops, userOpHash)
+	return nil
+}
+
+// FileQueueStore is a QueueStore keeping one JSON file per op in a
+// directory. Files are replaced atomically, so a crash mid-write leaves the
+// previous version intact.
+type FileQueueStore struct {
+	dir string
+}
+
+// NewFileQueueStore returns a store in dir, creating it if needed.
+func NewFileQueueStore(dir string) (*FileQueueStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FileQueueStore{dir: dir}, nil
+}
+
+func (s *FileQueueStore) path(hash common.Hash) string {
+	return filepath.Join(s.dir, hash.Hex()+".json")
+}
+
+func (s *FileQueueStore) Save(op *QueuedOp) error {
+	b, err := json.Marshal(op)
+	if err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(s.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), s.path(op.UserOpHash))
+}
+
+func (s *FileQueueStore) Load() ([]*QueuedOp, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+	var ops []*QueuedOp
+	for _, e := range entries {
+		if e.IsDir() || strings.HasPrefix(e.Name(), ".") || filepath.Ext(e.Name()) != ".json" {
+			continue
+		}
+		b, err := os.ReadFile(filepath.Join(s.dir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		op := new(QueuedOp)
+		if err := json.Unmarshal(b, op); err != nil {
+			return nil, err
+		}
+		ops = append(ops, op)
+	}
+	return ops, nil
+}
+
+func (s *FileQueueStore) Remove(userOpHash common.Hash) error {
+	err := os.Remove(s.path(userOpHash))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// SubmitQueue persists user operations before sending them and keeps
+// resending them until a receipt is seen, so that accepted ops are not lost
+// to bundler outages, dropped mempool entries or process restarts. Ops left
+// in the store by a previous process are picked up when the queue starts. It
+// is a Service.
+//
+// A send answered with "already known" counts as successful, as an earlier
+// send that seemed to fail may have reached the bundler. An op the bundler
+// rejects in validation (an AAxx code or error codes -32500 to -32507) is
+// removed and reported to OnComplete without being resent.
+type SubmitQueue struct {
+	loop
+
+	client   Client
+	store    QueueStore
+	interval time.Duration
+
+	// ResendAfter is how long a sent op may go without a receipt before it
+	// is sent again. MaxAttempts, if positive, bounds the number of sends,
+	// after which the op is removed and reported to OnComplete with its
+	// last error.
+	ResendAfter time.Duration
+	MaxAttempts int
+
+	// OnComplete, if set, is called when an op leaves the queue, with its
+	// receipt or the error that made the queue give up on it. OnError, if
+	// set, is called when polling fails.
+	OnComplete func(op *QueuedOp, receipt *UserOperationReceipt, err error)
+	OnError    func(error)
+
+	// mu guards the fields below. It is not held across RPCs; inFlight
+	// instead keeps Submit and the polling loop from handling the same op
+	// at once, and submitted records the ops Submit handled since the loop
+	// loaded the store, whose loaded copies are stale.
+	mu        sync.Mutex
+	chainId   *big.Int
+	inFlight  map[common.Hash]bool
+	submitted map[common.Hash]bool
+}
+
+func NewSubmitQueue(client Client, store QueueStore, interval time.Duration) *SubmitQueue {
+	q := &SubmitQueue{
+		client:      client,
+		store:       store,
+		interval:    interval,
+		ResendAfter: time.Minute,
+		inFlight:    make(map[common.Hash]bool),
+		submitted:   make(map[common.Hash]bool),
+	}
+	q.run = q.poll
+	return q
+}
+
+// Submit stores op and attempts to send it, returning its userOpHash. The op
+// stays queued if the send fails; the error is returned so the caller can
+// tell whether it went out, but it will be retried either way.
+func (q *SubmitQueue) Submit(ctx context.Context, op *UserOperation, entryPoint common.Address) (common.Hash, error) {
+	chainId, err := q.getChainId(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	qop := &QueuedOp{
+		UserOpHash: op.GetUserOpHash(entryPoint, chainId),
+		EntryPoint: entryPoint,
+		Op:         op,
+		CreatedAt:  time.Now(),
+	}
+	if !q.claim(qop.UserOpHash, false) {
+		// Already queued and being handled.
+		return qop.UserOpHash, nil
+	}
+	defer q.release(qop.UserOpHash, true)
+	if err := q.store.Save(qop); err != nil {
+		return common.Hash{}, err
+	}
+	return qop.UserOpHash, q.send(ctx, qop)
+}
+
+// claim marks the op with the given hash as being handled, returning false
+// if it already is or, for the polling loop, if Submit handled it since the
+// store was loaded.
+func (q *SubmitQueue) claim(hash common.Hash, polling bool) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.inFlight[hash] || (polling && q.submitted[hash]) {
+		return false
+	}
+	q.inFlight[hash] = true
+	return true
+}
+
+func (q *SubmitQueue) release(hash common.Hash, submitted bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	delete(q.inFlight, hash)
+	if submitted {
+		q.submitted[hash] = true
+	}
+}
+
+// Pending returns the ops currently queued, oldest first.
+func (q *SubmitQueue) Pending() ([]*QueuedOp, error) {
+	ops, err := q.store.Load()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(ops, func(i, j int) bool { return ops[i].CreatedAt.Before(ops[j].CreatedAt) })
+	return ops, nil
+}
+
+func (q *SubmitQueue) getChainId(ctx context.Context) (*big.Int, error) {
+	q.mu.Lock()
+	chainId := q.chainId
+	q.mu.Unlock()
+	if chainId != nil {
+		return chainId, nil
+	}
+	chainId, err := q.client.ChainId(ctx)
+	if err != nil {
+		return nil, err
+	}
+	q.mu.Lock()
+	q.chainId = chainId
+	q.mu.Unlock()
+	return chainId, nil
+}
+
+// rejected reports whether err is a validation failure that resending the
+// same op cannot fix.
+func rejected(err error) bool {
+	e, ok := aaerrors.Parse(err)
+	if !ok {
+		return false
+	}
+	return e.AACode != "" || (e.Code <= aaerrors.CodeSimulationRejected && e.Code >= aaerrors.CodeSignatureValidation)
+}
+
+// send sends qop and records the attempt in the store.
+func (q *SubmitQueue) send(ctx context.Context, qop *QueuedOp) error {
+	_, err := q.client.SendUserOperation(ctx, qop.Op, qop.EntryPoint)
+	if ctx.Err() != nil {
+		return err
+	}
+	if aaerrors.Is(err, aaerrors.ErrAlreadyKnown) {
+		err = nil
+	}
+	qop.Attempts++
+	qop.SentAt = time.Now()
+	qop.LastError = ""
+	if err != nil {
+		qop.LastError = err.Error()
+	}
+	if err != nil && (rejected(err) || (q.MaxAttempts > 0 && qop.Attempts >= q.MaxAttempts)) {
+		return errors.Join(err, q.complete(qop, nil, err))
+	}
+	if serr := q.store.Save(qop); serr != nil {
+		return errors.Join(err, serr)
+	}
+	return err
+}
+
+func (q *SubmitQueue) complete(qop *QueuedOp, receipt *UserOperationReceipt, err error) error {
+	if rerr := q.store.Remove(qop.UserOpHash); rerr != nil {
+		return rerr
+	}
+	if q.OnComplete != nil {
+		q.OnComplete(qop, receipt, err)
+	}
+	return nil
+}
+
+func (q *SubmitQueue) fail(ctx context.Context, err error) {
+	if ctx.Err() == nil && q.OnError != nil {
+		q.OnError(err)
+	}
+}
+
+func (q *SubmitQueue) poll(ctx context.Context) {
+	t := time.NewTicker(q.interval)
+	defer t.Stop()
+	for {
+		q.process(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+func (q *SubmitQueue) process(ctx context.Context) {
+	q.mu.Lock()
+	q.submitted = make(map[common.Hash]bool)
+	q.mu.Unlock()
+	ops, err := q.Pending()
+	if err != nil {
+		q.fail(ctx, err)
+		return
+	}
+	for _, qop := range ops {
+		if ctx.Err() != nil {
+			return
+		}
+		if !q.claim(qop.UserOpHash, true) {
+			continue
+		}
+		q.processOp(ctx, qop)
+		q.release(qop.UserOpHash, false)
+	}
+}
+
+func (q *SubmitQueue) processOp(ctx context.Context, qop *QueuedOp) {
+	// A failed send may still have reached the bundler, so look for a
+	// receipt before every resend.
+	if qop.Attempts > 0 {
+		receipt, err := q.client.GetUserOperationReceipt(ctx, qop.UserOpHash)
+		if err != nil {
+			q.fail(ctx, err)
+			return
+		}
+		if receipt != nil && receipt.UserOpHash == qop.UserOpHash {
+			if err := q.complete(qop, receipt, nil); err != nil {
+				q.fail(ctx, err)
+			}
+			return
+		}
+		if qop.LastError == "" && time.Since(qop.SentAt) < q.ResendAfter {
+			return
+		}
+	}
+	if err := q.send(ctx, qop); err != nil {
+		q.fail(ctx, err)
+	}
+}
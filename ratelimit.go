@@ -0,0 +1,162 @@
+package bundler_client
+
+import (
+	"context"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// RateLimits caps the request rate of a client. Send limits the methods
+// submitting ops or bundles (see MethodIdempotent), Read every other
+// method, and Methods overrides both for individual methods. A nil limiter
+// leaves the methods it covers unlimited.
+//
+// Requests waiting on the same limiter are served by MethodPriority, and in
+// arrival order within a priority, so that sends and receipt lookups are not
+// held up behind background reads such as mempool dumps.
+type RateLimits struct {
+	Send    *rate.Limiter
+	Read    *rate.Limiter
+	Methods map[string]*rate.Limiter
+
+	queues *limiterQueues
+}
+
+// WithRateLimit waits for the applicable limiter before every request,
+// including retries, so hosted bundlers are not pushed into rate limiting.
+func WithRateLimit(l RateLimits) DialOption {
+	l.queues = &limiterQueues{queues: make(map[*rate.Limiter]*limiterQueue)}
+	return func(c *dialConfig) { c.limits = &l }
+}
+
+// clone returns a copy of l with new limiters of the same limit and burst,
+// keeping limiters shared between methods shared.
+func (l *RateLimits) clone() *RateLimits {
+	clones := make(map[*rate.Limiter]*rate.Limiter)
+	cloneLimiter := func(lim *rate.Limiter) *rate.Limiter {
+		if lim == nil {
+			return nil
+		}
+		c, ok := clones[lim]
+		if !ok {
+			c = rate.NewLimiter(lim.Limit(), lim.Burst())
+			clones[lim] = c
+		}
+		return c
+	}
+	c := &RateLimits{
+		Send:   cloneLimiter(l.Send),
+		Read:   cloneLimiter(l.Read),
+		queues: &limiterQueues{queues: make(map[*rate.Limiter]*limiterQueue)},
+	}
+	if l.Methods != nil {
+		c.Methods = make(map[string]*rate.Limiter, len(l.Methods))
+		for method, lim := range l.Methods {
+			c.Methods[method] = cloneLimiter(lim)
+		}
+	}
+	return c
+}
+
+func (l *RateLimits) limiter(method string) *rate.Limiter {
+	if lim, ok := l.Methods[method]; ok {
+		return lim
+	}
+	if !MethodIdempotent(method) {
+		return l.Send
+	}
+	return l.Read
+}
+
+func (l *RateLimits) wait(ctx context.Context, method string) error {
+	lim := l.limiter(method)
+	if lim == nil {
+		return nil
+	}
+	if l.queues == nil {
+		return lim.Wait(ctx)
+	}
+	return l.queues.get(lim).wait(ctx, lim, MethodPriority(method))
+}
+
+type limiterQueues struct {
+	mu     sync.Mutex
+	queues map[*rate.Limiter]*limiterQueue
+}
+
+func (q *limiterQueues) get(lim *rate.Limiter) *limiterQueue {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	lq, ok := q.queues[lim]
+	if !ok {
+		lq = new(limiterQueue)
+		q.queues[lim] = lq
+	}
+	return lq
+}
+
+// limiterQueue lets one request at a time wait on a limiter, handing the
+// turn to the oldest waiter of the highest priority when it is done. Queued
+// requests therefore only reserve a token once they are at the front.
+type limiterQueue struct {
+	mu      sync.Mutex
+	busy    bool
+	waiters [numPriorities][]chan struct{}
+}
+
+func (q *limiterQueue) wait(ctx context.Context, lim *rate.Limiter, p Priority) error {
+	if p < PriorityBackground {
+		p = PriorityBackground
+	} else if p > PriorityHigh {
+		p = PriorityHigh
+	}
+	q.mu.Lock()
+	if !q.busy {
+		q.busy = true
+		q.mu.Unlock()
+	} else {
+		turn := make(chan struct{})
+		q.waiters[p] = append(q.waiters[p], turn)
+		q.mu.Unlock()
+		select {
+		case <-turn:
+		case <-ctx.Done():
+			q.mu.Lock()
+			queued := q.remove(p, turn)
+			q.mu.Unlock()
+			if !queued {
+				// The turn was handed over concurrently; pass it on.
+				q.next()
+			}
+			return ctx.Err()
+		}
+	}
+	defer q.next()
+	return lim.Wait(ctx)
+}
+
+func (q *limiterQueue) remove(p Priority, turn chan struct{}) bool {
+	for i, w := range q.waiters[p] {
+		if w == turn {
+			q.waiters[p] = append(q.waiters[p][:i], q.waiters[p][i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// next hands the turn to the next waiter, or marks the queue idle.
+func (q *limiterQueue) next() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for p := numPriorities - 1; p >= 0; p-- {
+		if len(q.waiters[p]) > 0 {
+			turn := q.waiters[p][0]
+			q.waiters[p] = q.waiters[p][1:]
+			close(turn)
+			return
+		}
+	}
+	q.busy = false
+}
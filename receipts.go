@@ -0,0 +1,149 @@
+package bundler_client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// receiptBatchSize is the number of lookups sent per JSON-RPC batch.
+const receiptBatchSize = 50
+
+// GetUserOperationReceipts looks up the receipts of many ops, returning them
+// in the order of hashes, with nil for ops that have none. Lookups are sent
+// as JSON-RPC batches, at most concurrency at a time; a batch the bundler
+// rejects as unsupported is retried as individual calls. Batches go through
+// the circuit breaker, rate limits (one token per lookup) and metrics, but
+// bypass the retry policy and hooks, which apply to the individual calls
+// only. If some
+// lookups fail, the other receipts are still returned along with a
+// *BatchError.
+func (c *RpcClient) GetUserOperationReceipts(ctx context.Context, hashes []common.Hash, concurrency int) ([]*UserOperationReceipt, error) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	receipts := make([]*UserOperationReceipt, len(hashes))
+	errs := make([]error, len(hashes))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for start := 0; start < len(hashes); start += receiptBatchSize {
+		end := start + receiptBatchSize
+		if end > len(hashes) {
+			end = len(hashes)
+		}
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(start, end int) {
+			defer func() { <-sem; wg.Done() }()
+			c.getReceiptBatch(ctx, hashes[start:end], receipts[start:end], errs[start:end])
+		}(start, end)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return receipts, &BatchError{Errors: errs}
+		}
+	}
+	return receipts, nil
+}
+
+func (c *RpcClient) getReceiptBatch(ctx context.Context, hashes []common.Hash, receipts []*UserOperationReceipt, errs []error) {
+	const method = "eth_getUserOperationReceipt"
+	fail := func(err error) {
+		for i := range errs {
+			errs[i] = err
+		}
+	}
+	if c.breaker != nil && !c.breaker.allow() {
+		if c.breaker.Fallback != nil {
+			c.breaker.Fallback.getReceiptBatch(ctx, hashes, receipts, errs)
+			return
+		}
+		fail(ErrCircuitOpen)
+		return
+	}
+	if c.limits != nil {
+		// A batch costs as much of the rate limit as its individual calls.
+		for range hashes {
+			if err := c.limits.wait(ctx, method); err != nil {
+				if c.breaker != nil {
+					c.breaker.release()
+				}
+				fail(err)
+				return
+			}
+		}
+	}
+
+	batch := make([]rpc.BatchElem, len(hashes))
+	for i, h := range hashes {
+		batch[i] = rpc.BatchElem{Method: method, Args: []interface{}{h}, Result: &receipts[i]}
+	}
+	if _, ok := ctx.Deadline(); !ok && c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+	start := time.Now()
+	err := c.c.BatchCallContext(ctx, batch)
+	d := time.Since(start)
+	unsupported := err != nil && batchUnsupported(err)
+	if c.breaker != nil {
+		if unsupported {
+			// The bundler answered, it just does not take batches.
+			c.breaker.record(d, nil)
+		} else {
+			c.breaker.record(d, err)
+		}
+	}
+	if unsupported {
+		for i, h := range hashes {
+			receipts[i], errs[i] = c.GetUserOperationReceipt(ctx, h)
+		}
+		return
+	}
+	for i, elem := range batch {
+		if err != nil {
+			c.stats.record(method, d, err)
+			receipts[i], errs[i] = nil, err
+			continue
+		}
+		c.stats.record(method, d, elem.Error)
+		if elem.Error != nil {
+			receipts[i], errs[i] = nil, elem.Error
+			continue
+		}
+		if r := receipts[i]; r != nil {
+			if c.CompatProfile().TolerantNumbers {
+				normalizeReceipt(r)
+			}
+			if err := r.DecodeEvents(); err != nil {
+				receipts[i], errs[i] = nil, err
+			}
+		}
+	}
+}
+
+// batchUnsupported reports whether err is the bundler rejecting a JSON-RPC
+// batch as a whole, rather than a transport failure: an error object or a
+// client error status in place of the array of responses.
+func batchUnsupported(err error) bool {
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		return true
+	}
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode >= http.StatusBadRequest && httpErr.StatusCode < http.StatusInternalServerError
+	}
+	var typeErr *json.UnmarshalTypeError
+	return errors.As(err, &typeErr)
+}
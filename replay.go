@@ -0,0 +1,48 @@
+package bundler_client
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ChainMismatchError reports that a user operation was signed for a different
+// chain than the one served by the connected bundler. SignedFor is nil when
+// the signed chain could not be identified among the candidates.
+type ChainMismatchError struct {
+	UserOpHash common.Hash
+	ChainId    *big.Int
+	SignedFor  *big.Int
+}
+
+func (e *ChainMismatchError) Error() string {
+	if e.SignedFor != nil {
+		return fmt.Sprintf("userop %s signed for chain %d, bundler is on chain %d", e.UserOpHash, e.SignedFor, e.ChainId)
+	}
+	return fmt.Sprintf("userop %s not signed for bundler chain %d", e.UserOpHash, e.ChainId)
+}
+
+// AuditChainId verifies that signedHash, the userOpHash the op's signature
+// commits to, matches the hash of op on the connected bundler's chain. On a
+// mismatch it returns a *ChainMismatchError, identifying the chain the op was
+// signed for if it is among candidates. This catches ops signed for another
+// network before the EntryPoint rejects them with AA24.
+func AuditChainId(ctx context.Context, c EthClient, op *UserOperation, entryPoint common.Address, signedHash common.Hash, candidates ...*big.Int) error {
+	chainId, err := c.ChainId(ctx)
+	if err != nil {
+		return err
+	}
+	if op.GetUserOpHash(entryPoint, chainId) == signedHash {
+		return nil
+	}
+	mismatch := &ChainMismatchError{UserOpHash: signedHash, ChainId: chainId}
+	for _, id := range candidates {
+		if op.GetUserOpHash(entryPoint, id) == signedHash {
+			mismatch.SignedFor = id
+			break
+		}
+	}
+	return mismatch
+}
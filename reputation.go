@@ -0,0 +1,181 @@
+package bundler_client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ReputationStatus is the reputation status of an entity as defined by
+// ERC-7562.
+type ReputationStatus int
+
+const (
+	ReputationOK ReputationStatus = iota
+	ReputationThrottled
+	ReputationBanned
+)
+
+var reputationStatusNames = []string{"ok", "throttled", "banned"}
+
+func (s ReputationStatus) String() string {
+	if s >= 0 && int(s) < len(reputationStatusNames) {
+		return reputationStatusNames[s]
+	}
+	return fmt.Sprintf("ReputationStatus(%d)", int(s))
+}
+
+func (s ReputationStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// UnmarshalJSON accepts the spec's string form ("ok", "throttled",
+// "banned") as well as the numeric form used by some bundlers.
+func (s *ReputationStatus) UnmarshalJSON(input []byte) error {
+	var name string
+	if err := json.Unmarshal(input, &name); err == nil {
+		for i, n := range reputationStatusNames {
+			if strings.EqualFold(name, n) {
+				*s = ReputationStatus(i)
+				return nil
+			}
+		}
+	}
+	var n TolerantBig
+	if err := n.UnmarshalJSON(input); err != nil {
+		return fmt.Errorf("invalid reputation status %s", input)
+	}
+	if i := n.ToInt(); i.IsInt64() && i.Int64() < int64(len(reputationStatusNames)) {
+		*s = ReputationStatus(i.Int64())
+		return nil
+	}
+	return fmt.Errorf("invalid reputation status %s", input)
+}
+
+// ReputationEntry is an entity's reputation as used by
+// debug_bundler_dumpReputation and debug_bundler_setReputation.
+type ReputationEntry struct {
+	Address     common.Address
+	OpsSeen     uint64
+	OpsIncluded uint64
+	Status      ReputationStatus
+}
+
+type reputationEntryJSON struct {
+	Address     common.Address    `json:"address"`
+	OpsSeen     *TolerantBig      `json:"opsSeen"`
+	OpsIncluded *TolerantBig      `json:"opsIncluded"`
+	Status      *ReputationStatus `json:"status,omitempty"`
+}
+
+func (e ReputationEntry) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		Address     common.Address   `json:"address"`
+		OpsSeen     hexutil.Uint64   `json:"opsSeen"`
+		OpsIncluded hexutil.Uint64   `json:"opsIncluded"`
+		Status      ReputationStatus `json:"status"`
+	}{e.Address, hexutil.Uint64(e.OpsSeen), hexutil.Uint64(e.OpsIncluded), e.Status})
+}
+
+func (e *ReputationEntry) UnmarshalJSON(input []byte) error {
+	var dec reputationEntryJSON
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	e.Address = dec.Address
+	if dec.OpsSeen != nil {
+		e.OpsSeen = dec.OpsSeen.ToInt().Uint64()
+	}
+	if dec.OpsIncluded != nil {
+		e.OpsIncluded = dec.OpsIncluded.ToInt().Uint64()
+	}
+	if dec.Status != nil {
+		e.Status = *dec.Status
+	}
+	return nil
+}
+
+// ReputationParams are the ERC-7562 reputation constants.
+type ReputationParams struct {
+	// MinInclusionRateDenominator defaults to the bundler value of
+	// DefaultReputationParams if zero.
+	MinInclusionRateDenominator uint64
+	ThrottlingSlack             uint64
+	BanSlack                    uint64
+}
+
+// DefaultReputationParams are the ERC-7562 values used by bundlers. RPC
+// clients use a MinInclusionRateDenominator of 100 instead of 10.
+var DefaultReputationParams = ReputationParams{
+	MinInclusionRateDenominator: 10,
+	ThrottlingSlack:             10,
+	BanSlack:                    50,
+}
+
+func (p ReputationParams) denominator() uint64 {
+	if p.MinInclusionRateDenominator == 0 {
+		return DefaultReputationParams.MinInclusionRateDenominator
+	}
+	return p.MinInclusionRateDenominator
+}
+
+func (p ReputationParams) maxSeen(e *ReputationEntry) uint64 {
+	return e.OpsSeen / p.denominator()
+}
+
+// Status computes the reputation status the bundler derives from the
+// entry's opsSeen and opsIncluded counters.
+func (p ReputationParams) Status(e *ReputationEntry) ReputationStatus {
+	maxSeen := p.maxSeen(e)
+	switch {
+	case maxSeen <= e.OpsIncluded+p.ThrottlingSlack:
+		return ReputationOK
+	case maxSeen <= e.OpsIncluded+p.BanSlack:
+		return ReputationThrottled
+	}
+	return ReputationBanned
+}
+
+// ThrottleHeadroom returns how many more ops can be seen without any being
+// included before the entity becomes throttled. It returns 0 for entities
+// that are already throttled or banned.
+func (p ReputationParams) ThrottleHeadroom(e *ReputationEntry) uint64 {
+	limit := (e.OpsIncluded + p.ThrottlingSlack + 1) * p.denominator()
+	if e.OpsSeen >= limit {
+		return 0
+	}
+	return limit - e.OpsSeen
+}
+
+// ReputationAlert flags a watched entity that is throttled, banned, or close
+// to being throttled.
+type ReputationAlert struct {
+	Entry    ReputationEntry
+	Status   ReputationStatus
+	Headroom uint64
+}
+
+// CheckReputation inspects entries for the watched entities (such as your
+// own paymasters and factories) and returns an alert for each that is not OK
+// or has fewer than warnHeadroom ops of headroom left before throttling.
+func CheckReputation(entries []ReputationEntry, watched map[common.Address]bool, p ReputationParams, warnHeadroom uint64) []ReputationAlert {
+	var alerts []ReputationAlert
+	for i := range entries {
+		e := &entries[i]
+		if !watched[e.Address] {
+			continue
+		}
+		status := e.Status
+		if computed := p.Status(e); computed > status {
+			status = computed
+		}
+		headroom := p.ThrottleHeadroom(e)
+		if status != ReputationOK || headroom < warnHeadroom {
+			alerts = append(alerts, ReputationAlert{Entry: *e, Status: status, Headroom: headroom})
+		}
+	}
+	return alerts
+}
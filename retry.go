@@ -0,0 +1,93 @@
+package bundler_client
+
+import (
+	"errors"
+	"math/rand"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// rateLimited is the JSON-RPC error code for rate limited requests.
+const rateLimited = -32005
+
+// RetryPolicy retries transient failures with exponential backoff and full
+// jitter. Failures where the request was certainly not processed (HTTP 429,
+// JSON-RPC -32005) are retried for every method; ambiguous failures (network
+// errors, HTTP 502-504) only for idempotent methods, so that an op is not
+// resent after a send that may have succeeded.
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	BaseDelay:   250 * time.Millisecond,
+	MaxDelay:    5 * time.Second,
+}
+
+// WithRetry retries transient failures according to p.
+func WithRetry(p RetryPolicy) DialOption {
+	return func(c *dialConfig) { c.retry = &p }
+}
+
+var (
+	nonIdempotentMethodsMu sync.RWMutex
+	nonIdempotentMethods   = map[string]bool{
+		"eth_sendUserOperation":       true,
+		"debug_bundler_sendBundleNow": true,
+		"debug_bundler_addUserOps":    true,
+		"eth_sendTransaction":         true,
+	}
+)
+
+// MethodIdempotent reports whether repeating the given RPC method is
+// harmless, making it safe to retry after an ambiguous failure.
+func MethodIdempotent(method string) bool {
+	nonIdempotentMethodsMu.RLock()
+	defer nonIdempotentMethodsMu.RUnlock()
+	return !nonIdempotentMethods[method]
+}
+
+// SetMethodIdempotent overrides the idempotency classification of the given
+// RPC method.
+func SetMethodIdempotent(method string, idempotent bool) {
+	nonIdempotentMethodsMu.Lock()
+	defer nonIdempotentMethodsMu.Unlock()
+	nonIdempotentMethods[method] = !idempotent
+}
+
+func (p *RetryPolicy) shouldRetry(method string, attempt int, err error) bool {
+	if attempt+1 >= p.MaxAttempts {
+		return false
+	}
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		return rpcErr.ErrorCode() == rateLimited
+	}
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) {
+		switch httpErr.StatusCode {
+		case http.StatusTooManyRequests:
+			return true
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return MethodIdempotent(method)
+		}
+		return false
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && MethodIdempotent(method)
+}
+
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.BaseDelay << attempt
+	if d <= 0 || d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	return time.Duration(rand.Int63n(int64(d) + 1))
+}
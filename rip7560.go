@@ -0,0 +1,128 @@
+package bundler_client
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// RIP7560TxType is the transaction type of RIP-7560 native account
+// abstraction transactions, as currently specified.
+const RIP7560TxType = 0x04
+
+// RIP7560Transaction is a RIP-7560 native account abstraction transaction.
+// Optional fields left nil are omitted, letting the node fill or estimate
+// them.
+type RIP7560Transaction struct {
+	Type                        hexutil.Uint64   `json:"type"`
+	ChainId                     *hexutil.Big     `json:"chainId,omitempty"`
+	Sender                      common.Address   `json:"sender"`
+	NonceKey                    *hexutil.Big     `json:"nonceKey"`
+	Nonce                       *hexutil.Big     `json:"nonce"`
+	AuthorizationData           hexutil.Bytes    `json:"authorizationData"`
+	ExecutionData               hexutil.Bytes    `json:"executionData"`
+	Deployer                    *common.Address  `json:"deployer,omitempty"`
+	DeployerData                hexutil.Bytes    `json:"deployerData,omitempty"`
+	Paymaster                   *common.Address  `json:"paymaster,omitempty"`
+	PaymasterData               hexutil.Bytes    `json:"paymasterData,omitempty"`
+	BuilderFee                  *hexutil.Big     `json:"builderFee,omitempty"`
+	MaxFeePerGas                *hexutil.Big     `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas        *hexutil.Big     `json:"maxPriorityFeePerGas,omitempty"`
+	ValidationGasLimit          *hexutil.Big     `json:"validationGasLimit,omitempty"`
+	PaymasterValidationGasLimit *hexutil.Big     `json:"paymasterValidationGasLimit,omitempty"`
+	PaymasterPostOpGasLimit     *hexutil.Big     `json:"paymasterPostOpGasLimit,omitempty"`
+	CallGasLimit                *hexutil.Big     `json:"callGasLimit,omitempty"`
+	AccessList                  types.AccessList `json:"accessList,omitempty"`
+}
+
+// RIP7560Receipt is the eth_getTransactionReceipt result of a RIP-7560
+// transaction. Status reflects the execution phase only; a transaction
+// failing validation is never included.
+type RIP7560Receipt struct {
+	Type              hexutil.Uint64  `json:"type"`
+	TransactionHash   common.Hash     `json:"transactionHash"`
+	BlockHash         common.Hash     `json:"blockHash"`
+	BlockNumber       *TolerantBig    `json:"blockNumber"`
+	TransactionIndex  *TolerantBig    `json:"transactionIndex"`
+	Sender            common.Address  `json:"sender"`
+	Paymaster         *common.Address `json:"paymaster"`
+	Deployer          *common.Address `json:"deployer"`
+	NonceKey          *TolerantBig    `json:"nonceKey"`
+	Nonce             *TolerantBig    `json:"nonce"`
+	Status            *TolerantBig    `json:"status"`
+	GasUsed           *TolerantBig    `json:"gasUsed"`
+	CumulativeGasUsed *TolerantBig    `json:"cumulativeGasUsed"`
+	EffectiveGasPrice *TolerantBig    `json:"effectiveGasPrice"`
+	Logs              []*types.Log    `json:"logs"`
+}
+
+// Success reports whether the transaction's execution phase succeeded.
+func (r *RIP7560Receipt) Success() bool {
+	return r.Status != nil && r.Status.ToInt().Uint64() == types.ReceiptStatusSuccessful
+}
+
+// RIP7560GasEstimates are the gas limits estimated for a RIP-7560
+// transaction.
+type RIP7560GasEstimates struct {
+	ValidationGasLimit          *TolerantBig `json:"validationGasLimit"`
+	PaymasterValidationGasLimit *TolerantBig `json:"paymasterValidationGasLimit"`
+	CallGasLimit                *TolerantBig `json:"callGasLimit"`
+	PaymasterPostOpGasLimit     *TolerantBig `json:"paymasterPostOpGasLimit"`
+}
+
+// NativeAAClient sends RIP-7560 transactions to chains with native account
+// abstraction. It shares the connection, and so the dial options, retries,
+// tracing and hooks, of the RpcClient it was obtained from.
+type NativeAAClient struct {
+	c *RpcClient
+}
+
+// NativeAA returns the RIP-7560 client for c.
+func (c *RpcClient) NativeAA() *NativeAAClient {
+	return &NativeAAClient{c: c}
+}
+
+// DialNativeAA connects to a RIP-7560 node.
+func DialNativeAA(ctx context.Context, rawurl string, opts ...DialOption) (*NativeAAClient, error) {
+	c, err := DialContext(ctx, rawurl, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return c.(*RpcClient).NativeAA(), nil
+}
+
+// SendTransaction submits tx, defaulting its type to RIP7560TxType.
+func (c *NativeAAClient) SendTransaction(ctx context.Context, tx *RIP7560Transaction) (common.Hash, error) {
+	if tx.Type == 0 {
+		tx.Type = RIP7560TxType
+	}
+	var hash common.Hash
+	err := c.c.call(ctx, &hash, "eth_sendTransaction", tx)
+	return hash, err
+}
+
+// EstimateGas returns the node's estimate of the transaction's gas limits.
+func (c *NativeAAClient) EstimateGas(ctx context.Context, tx *RIP7560Transaction) (*RIP7560GasEstimates, error) {
+	if tx.Type == 0 {
+		tx.Type = RIP7560TxType
+	}
+	var est RIP7560GasEstimates
+	err := c.c.call(ctx, &est, "eth_estimateGas", tx)
+	if err != nil {
+		return nil, err
+	}
+	return &est, nil
+}
+
+// GetTransactionReceipt returns the receipt of the transaction, or nil if it
+// is not yet included.
+func (c *NativeAAClient) GetTransactionReceipt(ctx context.Context, txHash common.Hash) (*RIP7560Receipt, error) {
+	var receipt *RIP7560Receipt
+	err := c.c.call(ctx, &receipt, "eth_getTransactionReceipt", txHash)
+	if err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
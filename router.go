@@ -0,0 +1,62 @@
+package bundler_client
+
+import (
+	"context"
+)
+
+// nodeMethods are the standard node methods that a client dialed with
+// DialRouted sends to the node rather than the bundler.
+var nodeMethods = map[string]bool{
+	"eth_call":                  true,
+	"eth_estimateGas":           true,
+	"eth_feeHistory":            true,
+	"eth_gasPrice":              true,
+	"eth_maxPriorityFeePerGas":  true,
+	"eth_blockNumber":           true,
+	"eth_getBalance":            true,
+	"eth_getCode":               true,
+	"eth_getTransactionCount":   true,
+	"eth_getTransactionReceipt": true,
+	"eth_getTransactionByHash":  true,
+	"eth_getBlockByNumber":      true,
+	"eth_getBlockByHash":        true,
+	"eth_getLogs":               true,
+	"debug_traceCall":           true,
+}
+
+// DialRouted connects to a bundler and a standard node, returning a single
+// client that sends the ERC-4337 methods to the bundler and the node methods
+// used by helpers such as SuggestGasFees, NonceManager, CallContract and the
+// chain adapters to the node. This suits bundlers that do not proxy eth_
+// methods, or whose proxied node is rate limited. Both connections use opts,
+// except that a WithCircuitBreaker breaker only guards the bundler, and the
+// node gets its own copies of any WithRateLimit limiters, as the two
+// endpoints are limited independently.
+func DialRouted(ctx context.Context, bundlerURL, nodeURL string, opts ...DialOption) (Client, error) {
+	c, err := DialContext(ctx, bundlerURL, opts...)
+	if err != nil {
+		return nil, err
+	}
+	rc := c.(*RpcClient)
+	node, err := DialContext(ctx, nodeURL, opts...)
+	if err != nil {
+		rc.c.Close()
+		return nil, err
+	}
+	rc.node = node.(*RpcClient)
+	rc.node.breaker = nil
+	if rc.limits != nil {
+		rc.node.limits = rc.limits.clone()
+	}
+	rc.node.stats.setBreaker(nil)
+	rc.stats.setNode(rc.node)
+	return rc, nil
+}
+
+// nodeClient returns the client serving node methods.
+func (c *RpcClient) nodeClient() *RpcClient {
+	if c.node != nil {
+		return c.node
+	}
+	return c
+}
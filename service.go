@@ -0,0 +1,122 @@
+package bundler_client
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// Service is implemented by every background component of this package
+// (watchers, pollers, health checks, resubmitters). Start launches the
+// component's goroutines and Stop terminates them, returning only once they
+// have all exited.
+type Service interface {
+	Start() error
+	Stop() error
+}
+
+var (
+	ErrServiceRunning = errors.New("service already running")
+	ErrServiceStopped = errors.New("service not running")
+)
+
+// loop runs a single background goroutine with Start/Stop semantics. It is
+// embedded by background components so that none of them can leak goroutines.
+type loop struct {
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+	run    func(ctx context.Context)
+}
+
+func (l *loop) Start() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.cancel != nil {
+		return ErrServiceRunning
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	l.cancel = cancel
+	l.done = make(chan struct{})
+	go func(done chan struct{}) {
+		defer close(done)
+		l.run(ctx)
+	}(l.done)
+	return nil
+}
+
+func (l *loop) Stop() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.cancel == nil {
+		return ErrServiceStopped
+	}
+	l.cancel()
+	<-l.done
+	l.cancel = nil
+	l.done = nil
+	return nil
+}
+
+// Supervisor manages a group of services collectively. Services are started
+// in the order they were added and stopped in reverse order.
+type Supervisor struct {
+	mu       sync.Mutex
+	services []Service
+	running  bool
+}
+
+func NewSupervisor(services ...Service) *Supervisor {
+	return &Supervisor{services: services}
+}
+
+// Add registers a service with the supervisor, starting it immediately if the
+// supervisor is already running.
+func (s *Supervisor) Add(svc Service) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.running {
+		if err := svc.Start(); err != nil {
+			return err
+		}
+	}
+	s.services = append(s.services, svc)
+	return nil
+}
+
+// Start starts all services. If any service fails to start, those already
+// started are stopped again and the error is returned.
+func (s *Supervisor) Start() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.running {
+		return ErrServiceRunning
+	}
+	for i, svc := range s.services {
+		if err := svc.Start(); err != nil {
+			for j := i - 1; j >= 0; j-- {
+				_ = s.services[j].Stop()
+			}
+			return err
+		}
+	}
+	s.running = true
+	return nil
+}
+
+// Stop stops all services, returning the joined errors of any that failed.
+func (s *Supervisor) Stop() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.running {
+		return ErrServiceStopped
+	}
+	var errs []error
+	for i := len(s.services) - 1; i >= 0; i-- {
+		if err := s.services[i].Stop(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	s.running = false
+	return errors.Join(errs...)
+}
@@ -0,0 +1,112 @@
+package bundler_client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"go.uber.org/goleak"
+)
+
+func TestLoopStartStop(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	l := &loop{run: func(ctx context.Context) { <-ctx.Done() }}
+	if err := l.Stop(); !errors.Is(err, ErrServiceStopped) {
+		t.Fatalf("Stop before Start: got %v, want %v", err, ErrServiceStopped)
+	}
+	for i := 0; i < 3; i++ {
+		if err := l.Start(); err != nil {
+			t.Fatal(err)
+		}
+		if err := l.Start(); !errors.Is(err, ErrServiceRunning) {
+			t.Fatalf("second Start: got %v, want %v", err, ErrServiceRunning)
+		}
+		if err := l.Stop(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestLoopRunReturnsEarly(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	returned := make(chan struct{})
+	l := &loop{run: func(context.Context) { close(returned) }}
+	if err := l.Start(); err != nil {
+		t.Fatal(err)
+	}
+	<-returned
+	if err := l.Stop(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// blockingDebugClient blocks every mempool dump until its context is done.
+type blockingDebugClient struct {
+	DebugClient
+	dumping chan struct{}
+}
+
+func (c *blockingDebugClient) BundlerDumpMempool(ctx context.Context, _ common.Address) ([]*UserOperation, error) {
+	select {
+	case c.dumping <- struct{}{}:
+	default:
+	}
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestStopCancelsInFlightCall(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	client := &blockingDebugClient{dumping: make(chan struct{}, 1)}
+	m := NewMempoolMonitor(client, EntryPointV06, time.Hour)
+	m.OnError = func(err error) { t.Errorf("OnError called on shutdown: %v", err) }
+	if err := m.Start(); err != nil {
+		t.Fatal(err)
+	}
+	<-client.dumping
+	if err := m.Stop(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+type failingService struct{}
+
+func (failingService) Start() error { return errors.New("start failed") }
+func (failingService) Stop() error  { return ErrServiceStopped }
+
+func TestSupervisor(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	newLoop := func() *loop { return &loop{run: func(ctx context.Context) { <-ctx.Done() }} }
+	s := NewSupervisor(newLoop(), newLoop())
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Add(newLoop()); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Stop(); !errors.Is(err, ErrServiceStopped) {
+		t.Fatalf("second Stop: got %v, want %v", err, ErrServiceStopped)
+	}
+}
+
+func TestSupervisorStartFailureStopsStarted(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	started := &loop{run: func(ctx context.Context) { <-ctx.Done() }}
+	s := NewSupervisor(started, failingService{})
+	if err := s.Start(); err == nil {
+		t.Fatal("Start succeeded despite a failing service")
+	}
+	if err := started.Stop(); !errors.Is(err, ErrServiceStopped) {
+		t.Fatalf("service started before the failure is still running: %v", err)
+	}
+}
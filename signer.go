@@ -0,0 +1,67 @@
+package bundler_client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Signer produces the signature an account validates a userop with, given
+// the op's hash.
+type Signer interface {
+	Sign(ctx context.Context, userOpHash common.Hash) ([]byte, error)
+}
+
+// ECDSASigner signs with a private key the way the reference SimpleAccount
+// expects: an EIP-191 personal signature of the userop hash, with v of 27
+// or 28.
+type ECDSASigner struct {
+	Key *ecdsa.PrivateKey
+}
+
+func NewECDSASigner(key *ecdsa.PrivateKey) *ECDSASigner {
+	return &ECDSASigner{Key: key}
+}
+
+// Address returns the signer's address, i.e. the account's owner.
+func (s *ECDSASigner) Address() common.Address {
+	return crypto.PubkeyToAddress(s.Key.PublicKey)
+}
+
+func (s *ECDSASigner) Sign(_ context.Context, userOpHash common.Hash) ([]byte, error) {
+	sig, err := crypto.Sign(accounts.TextHash(userOpHash.Bytes()), s.Key)
+	if err != nil {
+		return nil, err
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+	return sig, nil
+}
+
+// SignUserOperation computes op's hash for entryPoint and chainID, and sets
+// op.Signature to signer's signature of it.
+func SignUserOperation(ctx context.Context, signer Signer, op *UserOperation, entryPoint common.Address, chainID *big.Int) error {
+	sig, err := signer.Sign(ctx, GetUserOpHash(op, entryPoint, chainID))
+	if err != nil {
+		return err
+	}
+	op.Signature = sig
+	return nil
+}
+
+// SignPackedUserOperation is the v0.7 equivalent of SignUserOperation.
+func SignPackedUserOperation(ctx context.Context, signer Signer, op *PackedUserOperation, entryPoint common.Address, chainID *big.Int) error {
+	hash, err := GetPackedUserOpHash(op, entryPoint, chainID)
+	if err != nil {
+		return err
+	}
+	sig, err := signer.Sign(ctx, hash)
+	if err != nil {
+		return err
+	}
+	op.Signature = sig
+	return nil
+}
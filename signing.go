@@ -0,0 +1,92 @@
+package bundler_client
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"io"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// FlashbotsSignatureHeader is the header used by Flashbots-style relays and
+// builders to authenticate requests.
+const FlashbotsSignatureHeader = "X-Flashbots-Signature"
+
+// RequestSigner authenticates an HTTP request by its body, typically by
+// setting a signature header.
+type RequestSigner interface {
+	SignRequest(req *http.Request, body []byte) error
+}
+
+// FlashbotsSigner signs requests the Flashbots way: Header is set to
+// "<address>:<signature>", where signature is the EIP-191 personal signature
+// of the hex-encoded keccak256 hash of the body. Header defaults to
+// FlashbotsSignatureHeader.
+type FlashbotsSigner struct {
+	Key    *ecdsa.PrivateKey
+	Header string
+}
+
+func (s *FlashbotsSigner) SignRequest(req *http.Request, body []byte) error {
+	hash := hexutil.Encode(crypto.Keccak256(body))
+	sig, err := crypto.Sign(accounts.TextHash([]byte(hash)), s.Key)
+	if err != nil {
+		return err
+	}
+	header := s.Header
+	if header == "" {
+		header = FlashbotsSignatureHeader
+	}
+	req.Header.Set(header, crypto.PubkeyToAddress(s.Key.PublicKey).Hex()+":"+hexutil.Encode(sig))
+	return nil
+}
+
+// SigningTransport is an http.RoundTripper signing each request with Signer
+// before passing it to Base (http.DefaultTransport if nil).
+type SigningTransport struct {
+	Base   http.RoundTripper
+	Signer RequestSigner
+}
+
+func (t *SigningTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		_ = req.Body.Close()
+	}
+	// RoundTrippers must not modify the caller's request.
+	req = req.Clone(req.Context())
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	if err := t.Signer.SignRequest(req, body); err != nil {
+		return nil, err
+	}
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return base.RoundTrip(req)
+}
+
+// DialSigned connects to an HTTP endpoint that gates access by signed
+// payloads, signing every request with signer. Use a separate signer per
+// endpoint to authenticate with different keys. If opts include
+// WithHTTPClient, that client is kept and its transport wrapped.
+func DialSigned(ctx context.Context, rawurl string, signer RequestSigner, opts ...DialOption) (Client, error) {
+	var cfg dialConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	httpClient := &http.Client{}
+	if cfg.httpClient != nil {
+		*httpClient = *cfg.httpClient
+	}
+	httpClient.Transport = &SigningTransport{Signer: signer, Base: httpClient.Transport}
+	return DialContext(ctx, rawurl, append(opts, WithHTTPClient(httpClient))...)
+}
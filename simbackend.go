@@ -0,0 +1,166 @@
+package bundler_client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// SimulationReport is the diagnosis of an op by a SimulationBackend. Either
+// Execution is set, or FailedOp carries the reason the op failed. Trace and
+// URL are set by backends that provide them.
+type SimulationReport struct {
+	Execution *ExecutionResult
+	FailedOp  *FailedOpError
+	Trace     *CallFrame
+	// URL links to the simulation in the backend's UI.
+	URL string
+}
+
+// SimulationBackend diagnoses ops by simulating them against a v0.6
+// EntryPoint. Simulator implements it over eth_call; TenderlySimulator uses
+// Tenderly's simulation API, for teams without a node exposing the debug
+// namespace.
+type SimulationBackend interface {
+	SimulateUserOperation(ctx context.Context, op *UserOperation, entryPoint common.Address) (*SimulationReport, error)
+}
+
+// report wraps the outcome of simulateHandleOp, turning a FailedOp revert
+// into part of the report.
+func report(res *ExecutionResult, err error) (*SimulationReport, error) {
+	var failedOp *FailedOpError
+	if errors.As(err, &failedOp) {
+		return &SimulationReport{FailedOp: failedOp}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &SimulationReport{Execution: res}, nil
+}
+
+// SimulateUserOperation implements SimulationBackend. entryPoint overrides
+// s.EntryPoint if non-zero. Failed ops are traced if the backend is an
+// *RpcClient whose node supports debug_traceCall.
+func (s *Simulator) SimulateUserOperation(ctx context.Context, op *UserOperation, entryPoint common.Address) (*SimulationReport, error) {
+	if entryPoint == (common.Address{}) {
+		entryPoint = s.EntryPoint
+	}
+	r, err := report(simulateHandleOp(ctx, s.Backend, entryPoint, op, common.Address{}, nil))
+	if err != nil {
+		return nil, err
+	}
+	if c, ok := s.Backend.(*RpcClient); ok && r.FailedOp != nil {
+		r.Trace, _ = c.TraceUserOperation(ctx, op, entryPoint)
+	}
+	return r, nil
+}
+
+// TenderlySimulator is a SimulationBackend using the Tenderly simulation
+// API, or a compatible one at BaseURL. Simulations are saved, so the
+// report's URL opens them in the Tenderly dashboard.
+type TenderlySimulator struct {
+	Account   string
+	Project   string
+	AccessKey string
+	ChainId   *big.Int
+	// BaseURL defaults to https://api.tenderly.co, and HTTPClient to
+	// http.DefaultClient.
+	BaseURL    string
+	HTTPClient *http.Client
+}
+
+type tenderlyRequest struct {
+	NetworkId      string         `json:"network_id"`
+	From           common.Address `json:"from"`
+	To             common.Address `json:"to"`
+	Input          hexutil.Bytes  `json:"input"`
+	Save           bool           `json:"save"`
+	SaveIfFails    bool           `json:"save_if_fails"`
+	SimulationType string         `json:"simulation_type"`
+}
+
+type tenderlyResponse struct {
+	Transaction struct {
+		TransactionInfo struct {
+			CallTrace struct {
+				Output hexutil.Bytes `json:"output"`
+			} `json:"call_trace"`
+		} `json:"transaction_info"`
+	} `json:"transaction"`
+	Simulation struct {
+		Id string `json:"id"`
+	} `json:"simulation"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// SimulateUserOperation implements SimulationBackend.
+func (t *TenderlySimulator) SimulateUserOperation(ctx context.Context, op *UserOperation, entryPoint common.Address) (*SimulationReport, error) {
+	input, err := entryPointV06SimulateHandleOp.Pack("simulateHandleOp", op, common.Address{}, []byte{})
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(&tenderlyRequest{
+		NetworkId:      t.ChainId.String(),
+		To:             entryPoint,
+		Input:          input,
+		Save:           true,
+		SaveIfFails:    true,
+		SimulationType: "full",
+	})
+	if err != nil {
+		return nil, err
+	}
+	baseURL := t.BaseURL
+	if baseURL == "" {
+		baseURL = "https://api.tenderly.co"
+	}
+	url := fmt.Sprintf("%s/api/v1/account/%s/project/%s/simulate", baseURL, t.Account, t.Project)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Access-Key", t.AccessKey)
+	hc := t.HTTPClient
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+	resp, err := hc.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var res tenderlyResponse
+	if err := json.Unmarshal(b, &res); err != nil {
+		return nil, fmt.Errorf("tenderly: %s: %w", resp.Status, err)
+	}
+	if res.Error != nil {
+		return nil, fmt.Errorf("tenderly: %s", res.Error.Message)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("tenderly: %s", resp.Status)
+	}
+	// simulateHandleOp always reverts with its result.
+	r, err := report(decodeExecutionRevert(res.Transaction.TransactionInfo.CallTrace.Output))
+	if err != nil {
+		return nil, err
+	}
+	if res.Simulation.Id != "" {
+		r.URL = fmt.Sprintf("https://dashboard.tenderly.co/%s/%s/simulator/%s", t.Account, t.Project, res.Simulation.Id)
+	}
+	return r, nil
+}
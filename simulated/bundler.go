@@ -0,0 +1,387 @@
+// Package simulated provides an in-memory bundler implementing
+// bundler_client.Client on top of a development chain (a go-ethereum
+// simulated backend, anvil or geth --dev), so wallet code can be exercised
+// without any external bundler.
+//
+// The simulated bundler supports v0.6 EntryPoints only. It validates ops by
+// eth_call-ing handleOps, and bundles them by sending handleOps transactions
+// signed by the beneficiary key.
+package simulated
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	bundler_client "github.com/mdehoog/go-bundler-client"
+)
+
+var (
+	ErrOverridesUnsupported  = errors.New("state overrides are not supported by the simulated bundler")
+	ErrUnsupportedEntryPoint = errors.New("unsupported entrypoint")
+)
+
+// Backend is satisfied by *backends.SimulatedBackend and *ethclient.Client.
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+}
+
+// committer is implemented by backends that need explicit block production,
+// such as *backends.SimulatedBackend.
+type committer interface {
+	Commit() common.Hash
+}
+
+type Bundler struct {
+	backend     Backend
+	chainId     *big.Int
+	entryPoint  common.Address
+	beneficiary *ecdsa.PrivateKey
+
+	// bundleMu serializes bundling, so that an op is in at most one
+	// bundle.
+	bundleMu sync.Mutex
+	mu       sync.Mutex
+	mode     bundler_client.BundlingMode
+	mempool  []*bundler_client.UserOperation
+	ops      map[common.Hash]*bundler_client.HashLookupResult
+	receipts map[common.Hash]*bundler_client.UserOperationReceipt
+	// reputation is only stored and dumped; it does not affect validation.
+	reputation map[common.Address]bundler_client.ReputationEntry
+}
+
+var _ bundler_client.Client = (*Bundler)(nil)
+
+// NewBundler returns a simulated bundler for entryPoint that bundles in
+// auto mode, i.e. every accepted op is immediately bundled.
+func NewBundler(backend Backend, chainId *big.Int, entryPoint common.Address, beneficiary *ecdsa.PrivateKey) *Bundler {
+	return &Bundler{
+		backend:     backend,
+		chainId:     chainId,
+		entryPoint:  entryPoint,
+		beneficiary: beneficiary,
+		mode:        bundler_client.BundlingModeAuto,
+		ops:         make(map[common.Hash]*bundler_client.HashLookupResult),
+		receipts:    make(map[common.Hash]*bundler_client.UserOperationReceipt),
+		reputation:  make(map[common.Address]bundler_client.ReputationEntry),
+	}
+}
+
+func (b *Bundler) beneficiaryAddress() common.Address {
+	return crypto.PubkeyToAddress(b.beneficiary.PublicKey)
+}
+
+func (b *Bundler) checkEntryPoint(entryPoint common.Address) error {
+	if entryPoint != b.entryPoint {
+		return fmt.Errorf("%w: %s", ErrUnsupportedEntryPoint, entryPoint)
+	}
+	return nil
+}
+
+// validate eth_calls handleOps with the op alone, surfacing any FailedOp
+// revert as an error.
+func (b *Bundler) validate(ctx context.Context, op *bundler_client.UserOperation) error {
+	input, err := bundler_client.EncodeHandleOps([]*bundler_client.UserOperation{op}, b.beneficiaryAddress())
+	if err != nil {
+		return err
+	}
+	_, err = b.backend.CallContract(ctx, ethereum.CallMsg{From: b.beneficiaryAddress(), To: &b.entryPoint, Data: input}, nil)
+	return err
+}
+
+func (b *Bundler) SendUserOperation(ctx context.Context, op *bundler_client.UserOperation, entryPoint common.Address) (common.Hash, error) {
+	if err := b.checkEntryPoint(entryPoint); err != nil {
+		return common.Hash{}, err
+	}
+	if err := b.validate(ctx, op); err != nil {
+		return common.Hash{}, err
+	}
+	hash := op.GetUserOpHash(b.entryPoint, b.chainId)
+
+	b.mu.Lock()
+	b.mempool = append(b.mempool, op)
+	b.ops[hash] = &bundler_client.HashLookupResult{UserOperation: op, EntryPoint: b.entryPoint.Hex()}
+	auto := b.mode == bundler_client.BundlingModeAuto
+	b.mu.Unlock()
+
+	if auto {
+		if _, err := b.BundlerSendBundleNow(ctx); err != nil {
+			// The op is rejected, so it must not poison later bundles.
+			b.removeFromMempool([]*bundler_client.UserOperation{op})
+			b.mu.Lock()
+			delete(b.ops, hash)
+			b.mu.Unlock()
+			return common.Hash{}, err
+		}
+	}
+	return hash, nil
+}
+
+// EstimateUserOperationGas returns coarse estimates suitable for development:
+// the local PVG calculation, and the gas used by handleOps for the op as
+// both verification and call gas limit.
+func (b *Bundler) EstimateUserOperationGas(ctx context.Context, op *bundler_client.UserOperation, entryPoint common.Address) (*bundler_client.GasEstimates, error) {
+	if err := b.checkEntryPoint(entryPoint); err != nil {
+		return nil, err
+	}
+	input, err := bundler_client.EncodeHandleOps([]*bundler_client.UserOperation{op}, b.beneficiaryAddress())
+	if err != nil {
+		return nil, err
+	}
+	used, err := b.backend.EstimateGas(ctx, ethereum.CallMsg{From: b.beneficiaryAddress(), To: &b.entryPoint, Data: input})
+	if err != nil {
+		return nil, err
+	}
+	pvg, err := bundler_client.DefaultPVGConfig.CalcPreVerificationGas(op)
+	if err != nil {
+		return nil, err
+	}
+	limit := new(big.Int).SetUint64(used)
+	return &bundler_client.GasEstimates{
+		PreVerificationGas:   pvg,
+		VerificationGasLimit: limit,
+		CallGasLimit:         new(big.Int).Set(limit),
+		VerificationGas:      new(big.Int).Set(limit),
+	}, nil
+}
+
+func (b *Bundler) EstimateUserOperationGasWithOverrides(context.Context, *bundler_client.UserOperation, common.Address, map[common.Address]bundler_client.OverrideAccount) (*bundler_client.GasEstimates, error) {
+	return nil, ErrOverridesUnsupported
+}
+
+func (b *Bundler) GetUserOperationReceipt(_ context.Context, userOpHash common.Hash) (*bundler_client.UserOperationReceipt, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.receipts[userOpHash], nil
+}
+
+func (b *Bundler) GetUserOperationByHash(_ context.Context, userOpHash common.Hash) (*bundler_client.HashLookupResult, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.ops[userOpHash], nil
+}
+
+// GetUserOperationStatus reports known ops cleared from the mempool before
+// being bundled as dropped.
+func (b *Bundler) GetUserOperationStatus(_ context.Context, userOpHash common.Hash) (*bundler_client.UserOperationStatus, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if r, ok := b.receipts[userOpHash]; ok {
+		status := bundler_client.OpStatusIncluded
+		if !r.Success {
+			status = bundler_client.OpStatusReverted
+		}
+		hash := b.ops[userOpHash].TransactionHash
+		return &bundler_client.UserOperationStatus{Status: status, Raw: string(status), Receipt: r, TransactionHash: &hash}, nil
+	}
+	if _, ok := b.ops[userOpHash]; !ok {
+		return &bundler_client.UserOperationStatus{Status: bundler_client.OpStatusUnknown, Raw: string(bundler_client.OpStatusUnknown)}, nil
+	}
+	status := bundler_client.OpStatusDropped
+	for _, op := range b.mempool {
+		if op.GetUserOpHash(b.entryPoint, b.chainId) == userOpHash {
+			status = bundler_client.OpStatusPending
+		}
+	}
+	return &bundler_client.UserOperationStatus{Status: status, Raw: string(status)}, nil
+}
+
+func (b *Bundler) SupportedEntryPoints(context.Context) ([]common.Address, error) {
+	return []common.Address{b.entryPoint}, nil
+}
+
+func (b *Bundler) ChainId(context.Context) (*big.Int, error) {
+	return new(big.Int).Set(b.chainId), nil
+}
+
+func (b *Bundler) BundlerClearState(context.Context) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.mempool = nil
+	b.reputation = make(map[common.Address]bundler_client.ReputationEntry)
+	return nil
+}
+
+func (b *Bundler) BundlerDumpMempool(_ context.Context, entryPoint common.Address) ([]*bundler_client.UserOperation, error) {
+	if err := b.checkEntryPoint(entryPoint); err != nil {
+		return nil, err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]*bundler_client.UserOperation(nil), b.mempool...), nil
+}
+
+func (b *Bundler) BundlerSetBundlingMode(_ context.Context, mode bundler_client.BundlingMode) error {
+	if !mode.Valid() {
+		return fmt.Errorf("invalid bundling mode %q", mode)
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.mode = mode
+	return nil
+}
+
+func (b *Bundler) BundlerDumpReputation(_ context.Context, entryPoint common.Address) ([]bundler_client.ReputationEntry, error) {
+	if err := b.checkEntryPoint(entryPoint); err != nil {
+		return nil, err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	entries := make([]bundler_client.ReputationEntry, 0, len(b.reputation))
+	for _, e := range b.reputation {
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+func (b *Bundler) BundlerSetReputation(_ context.Context, entries []bundler_client.ReputationEntry, entryPoint common.Address) error {
+	if err := b.checkEntryPoint(entryPoint); err != nil {
+		return err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, e := range entries {
+		b.reputation[e.Address] = e
+	}
+	return nil
+}
+
+func (b *Bundler) BundlerGetBundlingMode(context.Context) (bundler_client.BundlingMode, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.mode, nil
+}
+
+// BundlerAddUserOps adds ops to the mempool as is, skipping validation and
+// auto-bundling.
+func (b *Bundler) BundlerAddUserOps(_ context.Context, ops []*bundler_client.UserOperation, entryPoint common.Address) error {
+	if err := b.checkEntryPoint(entryPoint); err != nil {
+		return err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, op := range ops {
+		b.mempool = append(b.mempool, op)
+		b.ops[op.GetUserOpHash(b.entryPoint, b.chainId)] = &bundler_client.HashLookupResult{UserOperation: op, EntryPoint: b.entryPoint.Hex()}
+	}
+	return nil
+}
+
+// BundlerSendBundleNow bundles the whole mempool into a single handleOps
+// transaction and waits for it to be mined. The ops stay in the mempool
+// until the transaction is sent, so that they can be bundled again if
+// building or sending it fails.
+func (b *Bundler) BundlerSendBundleNow(ctx context.Context) (*common.Hash, error) {
+	b.bundleMu.Lock()
+	defer b.bundleMu.Unlock()
+	b.mu.Lock()
+	ops := append([]*bundler_client.UserOperation(nil), b.mempool...)
+	b.mu.Unlock()
+	if len(ops) == 0 {
+		return nil, nil
+	}
+
+	input, err := bundler_client.EncodeHandleOps(ops, b.beneficiaryAddress())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(b.beneficiary, b.chainId)
+	if err != nil {
+		return nil, err
+	}
+	opts.Context = ctx
+	tx, err := bind.NewBoundContract(b.entryPoint, abi.ABI{}, b.backend, b.backend, b.backend).RawTransact(opts, input)
+	if err != nil {
+		return nil, err
+	}
+	b.removeFromMempool(ops)
+	if c, ok := b.backend.(committer); ok {
+		c.Commit()
+	}
+	receipt, err := bind.WaitMined(ctx, b.backend, tx)
+	if err != nil {
+		return nil, err
+	}
+	b.recordReceipts(receipt)
+	hash := tx.Hash()
+	return &hash, nil
+}
+
+// removeFromMempool removes ops, keeping any added since they were read.
+func (b *Bundler) removeFromMempool(ops []*bundler_client.UserOperation) {
+	sent := make(map[*bundler_client.UserOperation]bool, len(ops))
+	for _, op := range ops {
+		sent[op] = true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var kept []*bundler_client.UserOperation
+	for _, op := range b.mempool {
+		if !sent[op] {
+			kept = append(kept, op)
+		}
+	}
+	b.mempool = kept
+}
+
+func (b *Bundler) recordReceipts(receipt *types.Receipt) {
+	txReceipt := &bundler_client.TransactionReceipt{
+		BlockHash:         receipt.BlockHash,
+		BlockNumber:       hexutil.EncodeBig(receipt.BlockNumber),
+		From:              b.beneficiaryAddress(),
+		CumulativeGasUsed: hexutil.EncodeUint64(receipt.CumulativeGasUsed),
+		GasUsed:           hexutil.EncodeUint64(receipt.GasUsed),
+		Logs:              receipt.Logs,
+		LogsBloom:         receipt.Bloom,
+		TransactionHash:   receipt.TxHash,
+		TransactionIndex:  hexutil.EncodeUint64(uint64(receipt.TransactionIndex)),
+	}
+	if receipt.EffectiveGasPrice != nil {
+		txReceipt.EffectiveGasPrice = hexutil.EncodeBig(receipt.EffectiveGasPrice)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	// The logs of an op are those emitted since the previous op's
+	// UserOperationEvent.
+	start := 0
+	for i, log := range receipt.Logs {
+		e, ok, err := bundler_client.ParseUserOperationEvent(log)
+		if !ok || err != nil {
+			continue
+		}
+		opLogs := receipt.Logs[start : i+1]
+		start = i + 1
+		r := &bundler_client.UserOperationReceipt{
+			UserOpHash:    e.UserOpHash,
+			EntryPoint:    b.entryPoint,
+			Sender:        e.Sender,
+			Paymaster:     e.Paymaster,
+			Nonce:         "0x" + e.Nonce.Text(16),
+			Success:       e.Success,
+			ActualGasCost: "0x" + e.ActualGasCost.Text(16),
+			ActualGasUsed: "0x" + e.ActualGasUsed.Text(16),
+			From:          b.beneficiaryAddress(),
+			Receipt:       txReceipt,
+			Logs:          opLogs,
+		}
+		_ = r.DecodeEvents()
+		b.receipts[e.UserOpHash] = r
+		if l := b.ops[e.UserOpHash]; l != nil {
+			l.BlockNumber = (*hexutil.Big)(receipt.BlockNumber)
+			l.BlockHash = receipt.BlockHash
+			l.TransactionHash = receipt.TxHash
+		}
+	}
+}
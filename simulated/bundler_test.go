@@ -0,0 +1,70 @@
+package simulated
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	bundler_client "github.com/mdehoog/go-bundler-client"
+)
+
+func TestRecordReceipts(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	entryPoint := common.HexToAddress("0x5FF137D4b0FDCD49DcA30c7CF57E578a026d2789")
+	b := NewBundler(nil, big.NewInt(1337), entryPoint, key)
+
+	userOpHash := common.HexToHash("0x01")
+	sender := common.HexToAddress("0x02")
+	var data []byte
+	for _, word := range []*big.Int{big.NewInt(7), big.NewInt(1), big.NewInt(1000), big.NewInt(100)} {
+		data = append(data, common.LeftPadBytes(word.Bytes(), 32)...)
+	}
+	receipt := &types.Receipt{
+		TxHash:      common.HexToHash("0xaa"),
+		BlockHash:   common.HexToHash("0xbb"),
+		BlockNumber: big.NewInt(42),
+		GasUsed:     21000,
+		Logs: []*types.Log{{
+			Address: entryPoint,
+			Topics:  []common.Hash{bundler_client.UserOperationEventTopic, userOpHash, common.BytesToHash(sender.Bytes()), {}},
+			Data:    data,
+		}},
+	}
+	b.recordReceipts(receipt)
+
+	r, err := b.GetUserOperationReceipt(context.Background(), userOpHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r == nil {
+		t.Fatal("no receipt recorded")
+	}
+	if r.EntryPoint != entryPoint {
+		t.Errorf("entryPoint = %s, want %s", r.EntryPoint, entryPoint)
+	}
+	if r.Sender != sender || !r.Success || r.Nonce != "0x7" {
+		t.Errorf("unexpected op fields: %+v", r)
+	}
+	if r.Receipt == nil {
+		t.Fatal("no transaction receipt")
+	}
+	if r.Receipt.TransactionHash != receipt.TxHash {
+		t.Errorf("transactionHash = %s, want %s", r.Receipt.TransactionHash, receipt.TxHash)
+	}
+	if r.Receipt.BlockHash != receipt.BlockHash {
+		t.Errorf("blockHash = %s, want %s", r.Receipt.BlockHash, receipt.BlockHash)
+	}
+	if r.Receipt.BlockNumber != "0x2a" {
+		t.Errorf("blockNumber = %s, want 0x2a", r.Receipt.BlockNumber)
+	}
+	if r.Receipt.GasUsed != hexutil.EncodeUint64(21000) {
+		t.Errorf("gasUsed = %s, want %s", r.Receipt.GasUsed, hexutil.EncodeUint64(21000))
+	}
+}
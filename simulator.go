@@ -0,0 +1,121 @@
+package bundler_client
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ExecutionResult is the outcome of simulateHandleOp. TargetSuccess and
+// TargetResult report the optional call to the simulation target made after
+// the op executed.
+type ExecutionResult struct {
+	PreOpGas      *big.Int
+	Paid          *big.Int
+	ValidAfter    uint64
+	ValidUntil    uint64
+	TargetSuccess bool
+	TargetResult  []byte
+}
+
+// ValidAt reports whether the op's validity range, the intersection of the
+// account's and paymaster's, includes t. A ValidUntil of zero never expires.
+func (r *ValidationResult) ValidAt(t time.Time) bool {
+	return validAt(r.ValidAfter, r.ValidUntil, t)
+}
+
+// ValidAt is ValidationResult.ValidAt for simulated execution.
+func (r *ExecutionResult) ValidAt(t time.Time) bool {
+	return validAt(r.ValidAfter, r.ValidUntil, t)
+}
+
+func validAt(validAfter, validUntil uint64, t time.Time) bool {
+	now := uint64(t.Unix())
+	return now >= validAfter && (validUntil == 0 || now <= validUntil)
+}
+
+// Simulator runs a v0.6 EntryPoint's simulation methods over eth_call and
+// decodes their reverts, to see why a bundler rejected an op: a
+// *FailedOpError carries the AAxx reason, and the results carry the
+// prefund, signature failure and validity range the EntryPoint computed.
+// v0.7 EntryPoints moved simulation to an off-chain contract and are not
+// supported.
+type Simulator struct {
+	Backend    ethereum.ContractCaller
+	EntryPoint common.Address
+}
+
+func NewSimulator(backend ethereum.ContractCaller, entryPoint common.Address) *Simulator {
+	return &Simulator{Backend: backend, EntryPoint: entryPoint}
+}
+
+// Simulator returns a Simulator eth_calling through the client's connection.
+func (c *RpcClient) Simulator(entryPoint common.Address) *Simulator {
+	return NewSimulator(c, entryPoint)
+}
+
+// SimulateValidation runs simulateValidation on op.
+func (s *Simulator) SimulateValidation(ctx context.Context, op *UserOperation) (*ValidationResult, error) {
+	return SimulateValidation(ctx, s.Backend, op, s.EntryPoint)
+}
+
+// SimulateHandleOp runs simulateHandleOp on op, then calls target with
+// targetCallData if target is non-zero.
+func (s *Simulator) SimulateHandleOp(ctx context.Context, op *UserOperation, target common.Address, targetCallData []byte) (*ExecutionResult, error) {
+	return simulateHandleOp(ctx, s.Backend, s.EntryPoint, op, target, targetCallData)
+}
+
+// simulateHandleOp eth_calls simulateHandleOp, which always reverts, and
+// decodes the revert into a result or a *FailedOpError.
+func simulateHandleOp(ctx context.Context, backend ethereum.ContractCaller, entryPoint common.Address, op *UserOperation, target common.Address, targetCallData []byte) (*ExecutionResult, error) {
+	if targetCallData == nil {
+		targetCallData = []byte{}
+	}
+	input, err := entryPointV06SimulateHandleOp.Pack("simulateHandleOp", op, target, targetCallData)
+	if err != nil {
+		return nil, err
+	}
+	_, err = backend.CallContract(ctx, ethereum.CallMsg{To: &entryPoint, Data: input}, nil)
+	if err == nil {
+		return nil, ErrUnexpectedSimulationResult
+	}
+	data, ok := revertData(err)
+	if !ok {
+		return nil, err
+	}
+	res, derr := decodeExecutionRevert(data)
+	if derr == ErrUnexpectedSimulationResult {
+		return nil, err
+	}
+	return res, derr
+}
+
+// decodeExecutionRevert decodes the revert data of simulateHandleOp into a
+// result or a *FailedOpError.
+func decodeExecutionRevert(data []byte) (*ExecutionResult, error) {
+	if len(data) < 4 {
+		return nil, ErrUnexpectedSimulationResult
+	}
+	if executionResult := entryPointV06SimulateHandleOp.Errors["ExecutionResult"]; bytes.Equal(data[:4], executionResult.ID[:4]) {
+		values, err := executionResult.Inputs.Unpack(data[4:])
+		if err != nil {
+			return nil, err
+		}
+		return &ExecutionResult{
+			PreOpGas:      values[0].(*big.Int),
+			Paid:          values[1].(*big.Int),
+			ValidAfter:    values[2].(*big.Int).Uint64(),
+			ValidUntil:    values[3].(*big.Int).Uint64(),
+			TargetSuccess: values[4].(bool),
+			TargetResult:  values[5].([]byte),
+		}, nil
+	}
+	if failed, ok := DecodeFailedOp(data); ok {
+		return nil, failed
+	}
+	return nil, ErrUnexpectedSimulationResult
+}
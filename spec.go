@@ -0,0 +1,59 @@
+package bundler_client
+
+import (
+	"encoding/json"
+	"math/big"
+)
+
+// SpecVersion selects the revision of the bundler RPC spec the client
+// speaks.
+type SpecVersion int
+
+const (
+	// SpecLegacy is the RPC API as originally specified in ERC-4337. It is
+	// the default, and tolerates the field names of pre-v0.6 bundlers.
+	SpecLegacy SpecVersion = iota
+	// SpecERC7769 is the RPC API as specified in ERC-7769: unset gas fields
+	// are omitted from estimation requests rather than sent as null, and
+	// responses are decoded without the legacy field aliases.
+	SpecERC7769
+)
+
+// WithSpecVersion sets the RPC spec revision used to encode requests and
+// decode responses.
+func WithSpecVersion(v SpecVersion) DialOption {
+	return func(c *dialConfig) { c.spec = v }
+}
+
+func (c *RpcClient) SpecVersion() SpecVersion {
+	return c.spec
+}
+
+// estimationOp encodes an op for eth_estimateUserOperationGas under
+// ERC-7769, which makes the gas limits and fees optional.
+type estimationOp struct {
+	*UserOperation
+}
+
+func (op estimationOp) MarshalJSON() ([]byte, error) {
+	b, err := op.UserOperation.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	for name, v := range map[string]*big.Int{
+		"callGasLimit":         op.CallGasLimit,
+		"verificationGasLimit": op.VerificationGasLimit,
+		"preVerificationGas":   op.PreVerificationGas,
+		"maxFeePerGas":         op.MaxFeePerGas,
+		"maxPriorityFeePerGas": op.MaxPriorityFeePerGas,
+	} {
+		if v == nil {
+			delete(fields, name)
+		}
+	}
+	return json.Marshal(fields)
+}
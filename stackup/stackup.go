@@ -0,0 +1,158 @@
+// Package stackup converts between this module's types and the equivalent
+// types of github.com/stackup-wallet/stackup-bundler, for code that still
+// uses the stackup-bundler packages alongside the client.
+package stackup
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	bundler_client "github.com/mdehoog/go-bundler-client"
+	"github.com/stackup-wallet/stackup-bundler/pkg/entrypoint/filter"
+	"github.com/stackup-wallet/stackup-bundler/pkg/gas"
+	"github.com/stackup-wallet/stackup-bundler/pkg/userop"
+)
+
+func FromUserOperation(op *userop.UserOperation) *bundler_client.UserOperation {
+	if op == nil {
+		return nil
+	}
+	return &bundler_client.UserOperation{
+		Sender:               op.Sender,
+		Nonce:                op.Nonce,
+		InitCode:             op.InitCode,
+		CallData:             op.CallData,
+		CallGasLimit:         op.CallGasLimit,
+		VerificationGasLimit: op.VerificationGasLimit,
+		PreVerificationGas:   op.PreVerificationGas,
+		MaxFeePerGas:         op.MaxFeePerGas,
+		MaxPriorityFeePerGas: op.MaxPriorityFeePerGas,
+		PaymasterAndData:     op.PaymasterAndData,
+		Signature:            op.Signature,
+	}
+}
+
+func ToUserOperation(op *bundler_client.UserOperation) *userop.UserOperation {
+	if op == nil {
+		return nil
+	}
+	return &userop.UserOperation{
+		Sender:               op.Sender,
+		Nonce:                op.Nonce,
+		InitCode:             op.InitCode,
+		CallData:             op.CallData,
+		CallGasLimit:         op.CallGasLimit,
+		VerificationGasLimit: op.VerificationGasLimit,
+		PreVerificationGas:   op.PreVerificationGas,
+		MaxFeePerGas:         op.MaxFeePerGas,
+		MaxPriorityFeePerGas: op.MaxPriorityFeePerGas,
+		PaymasterAndData:     op.PaymasterAndData,
+		Signature:            op.Signature,
+	}
+}
+
+func FromGasEstimates(e *gas.GasEstimates) *bundler_client.GasEstimates {
+	if e == nil {
+		return nil
+	}
+	return &bundler_client.GasEstimates{
+		PreVerificationGas:   e.PreVerificationGas,
+		VerificationGasLimit: e.VerificationGasLimit,
+		CallGasLimit:         e.CallGasLimit,
+		VerificationGas:      e.VerificationGas,
+	}
+}
+
+func ToGasEstimates(e *bundler_client.GasEstimates) *gas.GasEstimates {
+	if e == nil {
+		return nil
+	}
+	return &gas.GasEstimates{
+		PreVerificationGas:   e.PreVerificationGas,
+		VerificationGasLimit: e.VerificationGasLimit,
+		CallGasLimit:         e.CallGasLimit,
+		VerificationGas:      e.VerificationGas,
+	}
+}
+
+func FromUserOperationReceipt(r *filter.UserOperationReceipt) *bundler_client.UserOperationReceipt {
+	if r == nil {
+		return nil
+	}
+	out := &bundler_client.UserOperationReceipt{
+		UserOpHash:    r.UserOpHash,
+		Sender:        r.Sender,
+		Paymaster:     r.Paymaster,
+		Nonce:         r.Nonce,
+		Success:       r.Success,
+		ActualGasCost: r.ActualGasCost,
+		ActualGasUsed: r.ActualGasUsed,
+		From:          r.From,
+		Logs:          r.Logs,
+	}
+	if r.Receipt != nil {
+		out.Receipt = &bundler_client.TransactionReceipt{
+			BlockHash:         r.Receipt.BlockHash,
+			BlockNumber:       r.Receipt.BlockNumber,
+			From:              r.Receipt.From,
+			CumulativeGasUsed: r.Receipt.CumulativeGasUsed,
+			GasUsed:           r.Receipt.GasUsed,
+			Logs:              r.Receipt.Logs,
+			LogsBloom:         r.Receipt.LogsBloom,
+			TransactionHash:   r.Receipt.TransactionHash,
+			TransactionIndex:  r.Receipt.TransactionIndex,
+			EffectiveGasPrice: r.Receipt.EffectiveGasPrice,
+		}
+	}
+	return out
+}
+
+func ToUserOperationReceipt(r *bundler_client.UserOperationReceipt) *filter.UserOperationReceipt {
+	if r == nil {
+		return nil
+	}
+	out := &filter.UserOperationReceipt{
+		UserOpHash:    r.UserOpHash,
+		Sender:        r.Sender,
+		Paymaster:     r.Paymaster,
+		Nonce:         r.Nonce,
+		Success:       r.Success,
+		ActualGasCost: r.ActualGasCost,
+		ActualGasUsed: r.ActualGasUsed,
+		From:          r.From,
+		Logs:          r.Logs,
+	}
+	if r.Receipt != nil {
+		// The stackup transaction receipt type is unexported, so it can only
+		// be populated by decoding. The two types share their JSON layout.
+		b, _ := json.Marshal(r.Receipt)
+		_ = json.Unmarshal(b, &out.Receipt)
+	}
+	return out
+}
+
+func FromHashLookupResult(r *filter.HashLookupResult) *bundler_client.HashLookupResult {
+	if r == nil {
+		return nil
+	}
+	return &bundler_client.HashLookupResult{
+		UserOperation:   FromUserOperation(r.UserOperation),
+		EntryPoint:      r.EntryPoint,
+		BlockNumber:     (*hexutil.Big)(r.BlockNumber),
+		BlockHash:       r.BlockHash,
+		TransactionHash: r.TransactionHash,
+	}
+}
+
+func ToHashLookupResult(r *bundler_client.HashLookupResult) *filter.HashLookupResult {
+	if r == nil {
+		return nil
+	}
+	return &filter.HashLookupResult{
+		UserOperation:   ToUserOperation(r.UserOperation),
+		EntryPoint:      r.EntryPoint,
+		BlockNumber:     r.BlockNumber.ToInt(),
+		BlockHash:       r.BlockHash,
+		TransactionHash: r.TransactionHash,
+	}
+}
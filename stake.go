@@ -0,0 +1,89 @@
+package bundler_client
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// StakeInfo is an entity's stake as reported by simulateValidation and
+// debug_bundler_getStakeStatus.
+type StakeInfo struct {
+	Address      common.Address
+	Stake        *big.Int
+	UnstakeDelay time.Duration
+}
+
+type stakeInfoJSON struct {
+	Address         common.Address `json:"addr"`
+	Stake           *TolerantBig   `json:"stake"`
+	UnstakeDelaySec *TolerantBig   `json:"unstakeDelaySec"`
+}
+
+func (s StakeInfo) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		Address         common.Address `json:"addr"`
+		Stake           *hexutil.Big   `json:"stake"`
+		UnstakeDelaySec hexutil.Uint64 `json:"unstakeDelaySec"`
+	}{s.Address, (*hexutil.Big)(s.Stake), hexutil.Uint64(s.UnstakeDelay / time.Second)})
+}
+
+func (s *StakeInfo) UnmarshalJSON(input []byte) error {
+	var dec stakeInfoJSON
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	s.Address = dec.Address
+	s.Stake = dec.Stake.ToInt()
+	if dec.UnstakeDelaySec != nil {
+		s.UnstakeDelay = time.Duration(dec.UnstakeDelaySec.ToInt().Int64()) * time.Second
+	}
+	return nil
+}
+
+// MeetsMinimumStake reports whether the stake and unstake delay are at least
+// the given minimums, as required of staked entities by ERC-7562.
+func (s *StakeInfo) MeetsMinimumStake(minStake *big.Int, minUnstakeDelay time.Duration) bool {
+	if s.Stake == nil || s.Stake.Cmp(minStake) < 0 {
+		return false
+	}
+	return s.UnstakeDelay >= minUnstakeDelay
+}
+
+// DepositInfo is an entity's deposit and stake held by the EntryPoint, as
+// returned by EntryPoint.getDepositInfo.
+type DepositInfo struct {
+	Deposit      *big.Int
+	Staked       bool
+	Stake        *big.Int
+	UnstakeDelay time.Duration
+	// WithdrawTime is the zero time if no unstake is pending.
+	WithdrawTime time.Time
+}
+
+func (d *DepositInfo) StakeInfo(addr common.Address) *StakeInfo {
+	return &StakeInfo{Address: addr, Stake: d.Stake, UnstakeDelay: d.UnstakeDelay}
+}
+
+func (d *DepositInfo) MeetsMinimumStake(minStake *big.Int, minUnstakeDelay time.Duration) bool {
+	return d.Staked && d.StakeInfo(common.Address{}).MeetsMinimumStake(minStake, minUnstakeDelay)
+}
+
+// StakeStatus is the result of debug_bundler_getStakeStatus.
+type StakeStatus struct {
+	StakeInfo StakeInfo `json:"stakeInfo"`
+	IsStaked  bool      `json:"isStaked"`
+}
+
+func (c *RpcClient) BundlerGetStakeStatus(ctx context.Context, address common.Address, entryPoint common.Address) (*StakeStatus, error) {
+	var status StakeStatus
+	err := c.call(ctx, &status, "debug_bundler_getStakeStatus", address, entryPoint)
+	if err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
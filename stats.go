@@ -0,0 +1,114 @@
+package bundler_client
+
+import (
+	"encoding/json"
+	"expvar"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// stats tracks per-method call counts, error counts and cumulative latency
+// as expvar values, keyed by RPC method name, alongside the state of the
+// client's circuit breaker and node connection.
+type stats struct {
+	mu      sync.Mutex
+	vars    *expvar.Map
+	byName  *expvar.Map
+	methods map[string]*methodStats
+}
+
+type methodStats struct {
+	calls   expvar.Int
+	errors  expvar.Int
+	latency expvar.Float
+}
+
+func newStats() *stats {
+	s := &stats{
+		vars:    new(expvar.Map).Init(),
+		byName:  new(expvar.Map).Init(),
+		methods: make(map[string]*methodStats),
+	}
+	s.vars.Set("methods", s.byName)
+	return s
+}
+
+// setBreaker exports the state of b, or removes it if b is nil.
+func (s *stats) setBreaker(b *CircuitBreaker) {
+	if b == nil {
+		s.vars.Delete("circuit_breaker")
+		return
+	}
+	s.vars.Set("circuit_breaker", expvar.Func(b.snapshot))
+}
+
+// setNode exports the stats of the client serving node methods.
+func (s *stats) setNode(node *RpcClient) {
+	s.vars.Set("node", node.stats.vars)
+}
+
+type breakerSnapshot struct {
+	State    string          `json:"state"`
+	Failures int             `json:"failures"`
+	Fallback json.RawMessage `json:"fallback,omitempty"`
+}
+
+func (b *CircuitBreaker) snapshot() interface{} {
+	b.mu.Lock()
+	s := breakerSnapshot{State: b.state.String(), Failures: b.failures}
+	b.mu.Unlock()
+	if b.Fallback != nil {
+		s.Fallback = json.RawMessage(b.Fallback.stats.vars.String())
+	}
+	return s
+}
+
+func (s *stats) method(method string) *methodStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m, ok := s.methods[method]
+	if !ok {
+		m = &methodStats{}
+		v := new(expvar.Map).Init()
+		v.Set("calls", &m.calls)
+		v.Set("errors", &m.errors)
+		v.Set("latency_seconds_total", &m.latency)
+		s.byName.Set(method, v)
+		s.methods[method] = m
+	}
+	return m
+}
+
+func (s *stats) record(method string, d time.Duration, err error) {
+	m := s.method(method)
+	m.calls.Add(1)
+	m.latency.Add(d.Seconds())
+	if err != nil {
+		m.errors.Add(1)
+	}
+}
+
+// Vars returns the client's internal metrics as an expvar.Var. The returned
+// value renders as a JSON object holding the per-method stats under
+// "methods", the circuit breaker's state, failure count and fallback stats
+// under "circuit_breaker" if WithCircuitBreaker is used, and the node
+// connection's stats under "node" for clients dialed with DialRouted.
+func (c *RpcClient) Vars() expvar.Var {
+	return c.stats.vars
+}
+
+// PublishExpvar publishes the client's internal metrics under the given
+// expvar name. Like expvar.Publish, it panics if the name is already in use.
+func (c *RpcClient) PublishExpvar(name string) {
+	expvar.Publish(name, c.stats.vars)
+}
+
+// Handler returns an http.Handler serving the client's internal metrics as
+// JSON, for services that do not expose the global expvar handler.
+func (c *RpcClient) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		_, _ = w.Write([]byte(c.stats.vars.String()))
+	})
+}
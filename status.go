@@ -0,0 +1,80 @@
+package bundler_client
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// UserOperationStatus is the result of eth_getUserOperationStatus. Bundlers
+// report status with their own vocabulary; Status maps it onto OpStatus and
+// Raw keeps the original value. Receipt is set for included and reverted ops
+// if the bundler returns it.
+type UserOperationStatus struct {
+	Status          OpStatus
+	Raw             string
+	Receipt         *UserOperationReceipt
+	TransactionHash *common.Hash
+	Reason          string
+}
+
+// opStatusAliases maps the status values of known bundlers onto OpStatus.
+var opStatusAliases = map[string]OpStatus{
+	"pending":                      OpStatusPending,
+	"new":                          OpStatusPending,
+	"submitted":                    OpStatusPending,
+	"preconfirmed":                 OpStatusPending,
+	"bundler_mempool":              OpStatusPending,
+	"included":                     OpStatusIncluded,
+	"mined":                        OpStatusIncluded,
+	"onchain":                      OpStatusIncluded,
+	"finalized":                    OpStatusIncluded,
+	"confirmed":                    OpStatusIncluded,
+	"success":                      OpStatusIncluded,
+	"reverted":                     OpStatusReverted,
+	"failed":                       OpStatusReverted,
+	"dropped":                      OpStatusDropped,
+	"cancelled":                    OpStatusDropped,
+	"rejected":                     OpStatusDropped,
+	"dropped_from_bundler_mempool": OpStatusDropped,
+	"replaced":                     OpStatusReplaced,
+}
+
+// ParseOpStatus maps a bundler-reported status onto OpStatus, returning
+// OpStatusUnknown for unrecognized values.
+func ParseOpStatus(s string) OpStatus {
+	if status, ok := opStatusAliases[strings.ToLower(s)]; ok {
+		return status
+	}
+	return OpStatusUnknown
+}
+
+func (s *UserOperationStatus) UnmarshalJSON(input []byte) error {
+	var dec struct {
+		Status          string                `json:"status"`
+		State           string                `json:"state"`
+		Receipt         *UserOperationReceipt `json:"receipt"`
+		Transaction     *common.Hash          `json:"transaction"`
+		TransactionHash *common.Hash          `json:"transactionHash"`
+		Reason          string                `json:"reason"`
+	}
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	s.Raw = dec.Status
+	if s.Raw == "" {
+		s.Raw = dec.State
+	}
+	s.Status = ParseOpStatus(s.Raw)
+	s.Receipt = dec.Receipt
+	s.TransactionHash = dec.TransactionHash
+	if s.TransactionHash == nil {
+		s.TransactionHash = dec.Transaction
+	}
+	if s.TransactionHash == nil && s.Receipt != nil && s.Receipt.Receipt != nil {
+		s.TransactionHash = &s.Receipt.Receipt.TransactionHash
+	}
+	s.Reason = dec.Reason
+	return nil
+}
@@ -0,0 +1,103 @@
+package bundler_client
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// pendingUserOperationsSubscription is the eth_subscribe subscription name
+// used by bundlers streaming their userop mempool.
+const pendingUserOperationsSubscription = "pendingUserOperations"
+
+// SubscribePendingUserOperations subscribes to ops entering the bundler's
+// mempool for entryPoint, delivering them on ch. It requires a WebSocket
+// (or IPC) connection and a bundler supporting the subscription; over HTTP
+// it fails with rpc.ErrNotificationsUnsupported.
+func (c *RpcClient) SubscribePendingUserOperations(ctx context.Context, entryPoint common.Address, ch chan<- *UserOperation) (ethereum.Subscription, error) {
+	raw := make(chan *UserOperation)
+	sub, err := c.c.EthSubscribe(ctx, raw, pendingUserOperationsSubscription, entryPoint)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case op := <-raw:
+				select {
+				case ch <- op:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// SubscribeUserOperationEvent subscribes to UserOperationEvent logs of
+// entryPoint, delivering the decoded events on ch as ops are included. The
+// events can be restricted to userOpHashes and/or senders; a nil slice
+// matches any value. Logs removed by a reorg are not delivered. Like
+// SubscribePendingUserOperations it needs a WebSocket or IPC connection to a
+// bundler or node serving eth_subscribe logs.
+func (c *RpcClient) SubscribeUserOperationEvent(ctx context.Context, entryPoint common.Address, userOpHashes []common.Hash, senders []common.Address, ch chan<- *UserOperationEvent) (ethereum.Subscription, error) {
+	topics := []interface{}{[]common.Hash{UserOperationEventTopic}, nil, nil}
+	if userOpHashes != nil {
+		topics[1] = userOpHashes
+	}
+	if senders != nil {
+		var senderTopics []common.Hash
+		for _, s := range senders {
+			senderTopics = append(senderTopics, common.BytesToHash(s.Bytes()))
+		}
+		topics[2] = senderTopics
+	}
+	filter := map[string]interface{}{
+		"address": entryPoint,
+		"topics":  topics,
+	}
+	raw := make(chan types.Log)
+	sub, err := c.nodeClient().c.EthSubscribe(ctx, raw, "logs", filter)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-raw:
+				if log.Removed {
+					continue
+				}
+				e, ok, err := ParseUserOperationEvent(&log)
+				if !ok {
+					continue
+				}
+				if err != nil {
+					return err
+				}
+				select {
+				case ch <- e:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
@@ -0,0 +1,122 @@
+package bundler_client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/mdehoog/go-bundler-client"
+
+type tracingConfig struct {
+	provider   trace.TracerProvider
+	propagator propagation.TextMapPropagator
+}
+
+// WithTracing records an OpenTelemetry span for every RPC call, carrying the
+// method, entrypoint and userOpHash, and injects the trace context into the
+// headers of HTTP requests with propagator. Nil arguments select the global
+// provider and propagator.
+func WithTracing(provider trace.TracerProvider, propagator propagation.TextMapPropagator) DialOption {
+	return func(c *dialConfig) { c.tracing = &tracingConfig{provider: provider, propagator: propagator} }
+}
+
+func (t *tracingConfig) tracer() trace.Tracer {
+	provider := t.provider
+	if provider == nil {
+		provider = otel.GetTracerProvider()
+	}
+	return provider.Tracer(tracerName)
+}
+
+// wrap returns a copy of hc (or of the default client if nil) injecting the
+// trace context into outgoing requests.
+func (t *tracingConfig) wrap(hc *http.Client) *http.Client {
+	wrapped := &http.Client{}
+	if hc != nil {
+		*wrapped = *hc
+	}
+	propagator := t.propagator
+	if propagator == nil {
+		propagator = otel.GetTextMapPropagator()
+	}
+	wrapped.Transport = &propagatingTransport{base: wrapped.Transport, propagator: propagator}
+	return wrapped
+}
+
+type propagatingTransport struct {
+	base       http.RoundTripper
+	propagator propagation.TextMapPropagator
+}
+
+func (t *propagatingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	t.propagator.Inject(req.Context(), propagation.HeaderCarrier(req.Header))
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return base.RoundTrip(req)
+}
+
+// entryPointArgs and userOpHashArgs give the position of the entrypoint and
+// userOpHash arguments of the methods that take them.
+var (
+	entryPointArgs = map[string]int{
+		"eth_sendUserOperation":        1,
+		"eth_estimateUserOperationGas": 1,
+		"eth_validateUserOperation":    1,
+		"pm_getPaymasterStubData":      1,
+		"pm_getPaymasterData":          1,
+		"pm_sponsorUserOperation":      1,
+		"debug_bundler_dumpMempool":    0,
+		"debug_bundler_addUserOps":     1,
+		"debug_bundler_dumpReputation": 0,
+		"debug_bundler_setReputation":  1,
+		"debug_bundler_getStakeStatus": 1,
+	}
+	userOpHashArgs = map[string]int{
+		"eth_getUserOperationReceipt":     0,
+		"eth_getUserOperationByHash":      0,
+		"eth_getUserOperationStatus":      0,
+		"pimlico_getUserOperationStatus":  0,
+		"biconomy_getUserOperationStatus": 0,
+	}
+)
+
+// startSpan starts the span of an RPC call, tagged with the entrypoint and
+// userOpHash arguments of the methods that take them.
+func (c *RpcClient) startSpan(ctx context.Context, method string, args []interface{}) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{
+		attribute.String("rpc.system", "jsonrpc"),
+		attribute.String("rpc.method", method),
+		attribute.String("server.address", c.endpoint),
+	}
+	if i, ok := entryPointArgs[method]; ok && i < len(args) {
+		if ep, ok := args[i].(common.Address); ok {
+			attrs = append(attrs, attribute.String("erc4337.entrypoint", ep.Hex()))
+		}
+	}
+	if i, ok := userOpHashArgs[method]; ok && i < len(args) {
+		if hash, ok := args[i].(common.Hash); ok {
+			attrs = append(attrs, attribute.String("erc4337.userop_hash", hash.Hex()))
+		}
+	}
+	return c.tracer.Start(ctx, method, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+}
+
+func endSpan(span trace.Span, method string, result interface{}, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	} else if hash, ok := result.(*common.Hash); ok && method == "eth_sendUserOperation" {
+		span.SetAttributes(attribute.String("erc4337.userop_hash", hash.Hex()))
+	}
+	span.End()
+}
@@ -0,0 +1,90 @@
+package bundler_client
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// TrackUserOperation follows a submitted op until its outcome is known,
+// returning a transition with status OpStatusIncluded or OpStatusReverted
+// (with the receipt), OpStatusReplaced if the op's nonce was consumed by a
+// different op, or OpStatusDropped if the bundler no longer knows the op and
+// no receipt appeared within dropAfter. The nonce is read from the EntryPoint
+// through backend; if backend is nil, replacement is only detected when c can
+// eth_call itself, as *RpcClient can. It returns early with ctx's error.
+func TrackUserOperation(ctx context.Context, c Client, backend ethereum.ContractCaller, op *UserOperation, entryPoint common.Address, interval, dropAfter time.Duration) (*OpTransition, error) {
+	if backend == nil {
+		backend, _ = c.(ethereum.ContractCaller)
+	}
+	chainId, err := c.ChainId(ctx)
+	if err != nil {
+		return nil, err
+	}
+	hash := op.GetUserOpHash(entryPoint, chainId)
+	t := &OpTransition{UserOpHash: hash, Sender: op.Sender, Nonce: op.Nonce}
+
+	included := func() (bool, error) {
+		receipt, err := c.GetUserOperationReceipt(ctx, hash)
+		if err != nil || receipt == nil || receipt.UserOpHash != hash {
+			return false, err
+		}
+		t.Status = OpStatusIncluded
+		if !receipt.Success {
+			t.Status = OpStatusReverted
+		}
+		t.Receipt = receipt
+		t.Time = time.Now()
+		return true, nil
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	var missingSince time.Time
+	for {
+		if done, err := included(); err != nil {
+			return nil, err
+		} else if done {
+			return t, nil
+		}
+		if backend != nil {
+			next, err := GetNonce(ctx, backend, entryPoint, op.Sender, NonceKey(op.Nonce))
+			if err != nil {
+				return nil, err
+			}
+			if NonceSequence(next) > NonceSequence(op.Nonce) {
+				// The op may have been included since the receipt check.
+				if done, err := included(); err != nil {
+					return nil, err
+				} else if done {
+					return t, nil
+				}
+				t.Status = OpStatusReplaced
+				t.Time = time.Now()
+				return t, nil
+			}
+		}
+		lookup, err := c.GetUserOperationByHash(ctx, hash)
+		if err != nil {
+			return nil, err
+		}
+		if lookup == nil {
+			if missingSince.IsZero() {
+				missingSince = time.Now()
+			} else if time.Since(missingSince) >= dropAfter {
+				t.Status = OpStatusDropped
+				t.Time = time.Now()
+				return t, nil
+			}
+		} else {
+			missingSince = time.Time{}
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,356 @@
+package bundler_client
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// UserOpType is the ABI type of a v0.6 UserOperation.
+var UserOpType, _ = abi.NewType("tuple", "op", []abi.ArgumentMarshaling{
+	{Name: "sender", Type: "address"},
+	{Name: "nonce", Type: "uint256"},
+	{Name: "initCode", Type: "bytes"},
+	{Name: "callData", Type: "bytes"},
+	{Name: "callGasLimit", Type: "uint256"},
+	{Name: "verificationGasLimit", Type: "uint256"},
+	{Name: "preVerificationGas", Type: "uint256"},
+	{Name: "maxFeePerGas", Type: "uint256"},
+	{Name: "maxPriorityFeePerGas", Type: "uint256"},
+	{Name: "paymasterAndData", Type: "bytes"},
+	{Name: "signature", Type: "bytes"},
+})
+
+// UserOperation is a v0.6 ERC-4337 user operation. It is encoded to JSON
+// with hex quantities and byte strings, as used by the bundler RPC API.
+type UserOperation struct {
+	Sender               common.Address
+	Nonce                *big.Int
+	InitCode             []byte
+	CallData             []byte
+	CallGasLimit         *big.Int
+	VerificationGasLimit *big.Int
+	PreVerificationGas   *big.Int
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	PaymasterAndData     []byte
+	Signature            []byte
+	// Eip7702Auth is the EIP-7702 authorization delegating an EOA sender to
+	// its account code, for bundlers supporting delegated EOAs. It is only
+	// sent if set, and is not part of the ABI encoding or the op hash.
+	Eip7702Auth *Eip7702Auth
+}
+
+// Eip7702Auth is a signed EIP-7702 authorization tuple.
+type Eip7702Auth struct {
+	ChainId *hexutil.Big   `json:"chainId"`
+	Address common.Address `json:"address"`
+	Nonce   hexutil.Uint64 `json:"nonce"`
+	YParity hexutil.Uint64 `json:"yParity"`
+	R       *hexutil.Big   `json:"r"`
+	S       *hexutil.Big   `json:"s"`
+}
+
+type userOperationJSON struct {
+	Sender               common.Address `json:"sender"`
+	Nonce                *hexutil.Big   `json:"nonce"`
+	InitCode             hexutil.Bytes  `json:"initCode"`
+	CallData             hexutil.Bytes  `json:"callData"`
+	CallGasLimit         *hexutil.Big   `json:"callGasLimit"`
+	VerificationGasLimit *hexutil.Big   `json:"verificationGasLimit"`
+	PreVerificationGas   *hexutil.Big   `json:"preVerificationGas"`
+	MaxFeePerGas         *hexutil.Big   `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big   `json:"maxPriorityFeePerGas"`
+	PaymasterAndData     hexutil.Bytes  `json:"paymasterAndData"`
+	Signature            hexutil.Bytes  `json:"signature"`
+	Eip7702Auth          *Eip7702Auth   `json:"eip7702Auth,omitempty"`
+}
+
+// MarshalJSON has a value receiver so that ops are encoded the same whether
+// held by value or by pointer.
+func (op UserOperation) MarshalJSON() ([]byte, error) {
+	// Bundlers conventionally echo the checksummed sender.
+	type enc struct {
+		Sender string `json:"sender"`
+		*userOperationJSON
+	}
+	return json.Marshal(&enc{op.Sender.Hex(), &userOperationJSON{
+		Nonce:                (*hexutil.Big)(op.Nonce),
+		InitCode:             op.InitCode,
+		CallData:             op.CallData,
+		CallGasLimit:         (*hexutil.Big)(op.CallGasLimit),
+		VerificationGasLimit: (*hexutil.Big)(op.VerificationGasLimit),
+		PreVerificationGas:   (*hexutil.Big)(op.PreVerificationGas),
+		MaxFeePerGas:         (*hexutil.Big)(op.MaxFeePerGas),
+		MaxPriorityFeePerGas: (*hexutil.Big)(op.MaxPriorityFeePerGas),
+		PaymasterAndData:     op.PaymasterAndData,
+		Signature:            op.Signature,
+		Eip7702Auth:          op.Eip7702Auth,
+	}})
+}
+
+func (op *UserOperation) UnmarshalJSON(input []byte) error {
+	var dec userOperationJSON
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	*op = UserOperation{
+		Sender:               dec.Sender,
+		Nonce:                dec.Nonce.ToInt(),
+		InitCode:             canonicalBytes(dec.InitCode),
+		CallData:             canonicalBytes(dec.CallData),
+		CallGasLimit:         dec.CallGasLimit.ToInt(),
+		VerificationGasLimit: dec.VerificationGasLimit.ToInt(),
+		PreVerificationGas:   dec.PreVerificationGas.ToInt(),
+		MaxFeePerGas:         dec.MaxFeePerGas.ToInt(),
+		MaxPriorityFeePerGas: dec.MaxPriorityFeePerGas.ToInt(),
+		PaymasterAndData:     canonicalBytes(dec.PaymasterAndData),
+		Signature:            canonicalBytes(dec.Signature),
+		Eip7702Auth:          dec.Eip7702Auth,
+	}
+	return nil
+}
+
+// canonicalBytes decodes an empty byte string, which marshals as "0x", to
+// nil, so that ops survive a JSON round-trip unchanged.
+func canonicalBytes(b hexutil.Bytes) []byte {
+	if len(b) == 0 {
+		return nil
+	}
+	return b
+}
+
+// GetPaymaster returns the paymaster address from paymasterAndData, or the
+// zero address if the op has no paymaster.
+func (op *UserOperation) GetPaymaster() common.Address {
+	if len(op.PaymasterAndData) < common.AddressLength {
+		return common.Address{}
+	}
+	return common.BytesToAddress(op.PaymasterAndData[:common.AddressLength])
+}
+
+// GetFactory returns the factory address from initCode, or the zero address
+// if the op does not deploy its account.
+func (op *UserOperation) GetFactory() common.Address {
+	if len(op.InitCode) < common.AddressLength {
+		return common.Address{}
+	}
+	return common.BytesToAddress(op.InitCode[:common.AddressLength])
+}
+
+// Pack returns the ABI encoding of op as it appears in handleOps calldata,
+// without the leading offset word of PackUserOperation. It is the payload
+// used for calldata cost estimates, not for hashing.
+func (op *UserOperation) Pack() ([]byte, error) {
+	packed, err := PackUserOperation(op)
+	if err != nil {
+		return nil, err
+	}
+	return packed[32:], nil
+}
+
+// PackForSignature returns the encoding of op that the EntryPoint hashes in
+// getUserOpHash. All its fields are static words, so unlike Pack it cannot
+// fail; unset quantities are encoded as zero.
+func (op *UserOperation) PackForSignature() []byte {
+	return bytes.Join([][]byte{
+		common.LeftPadBytes(op.Sender.Bytes(), 32),
+		uint256Word(op.Nonce),
+		crypto.Keccak256(op.InitCode),
+		crypto.Keccak256(op.CallData),
+		uint256Word(op.CallGasLimit),
+		uint256Word(op.VerificationGasLimit),
+		uint256Word(op.PreVerificationGas),
+		uint256Word(op.MaxFeePerGas),
+		uint256Word(op.MaxPriorityFeePerGas),
+		crypto.Keccak256(op.PaymasterAndData),
+	}, nil)
+}
+
+// GetUserOpHash returns the hash of op, entryPoint and chainID that the
+// op's signature commits to.
+func (op *UserOperation) GetUserOpHash(entryPoint common.Address, chainID *big.Int) common.Hash {
+	return GetUserOpHash(op, entryPoint, chainID)
+}
+
+// GasEstimates is the result of eth_estimateUserOperationGas.
+type GasEstimates struct {
+	PreVerificationGas   *big.Int `json:"preVerificationGas"`
+	VerificationGasLimit *big.Int `json:"verificationGasLimit"`
+	CallGasLimit         *big.Int `json:"callGasLimit"`
+	// VerificationGas is the pre-v0.6 name of VerificationGasLimit, still
+	// returned by some bundlers.
+	VerificationGas *big.Int `json:"verificationGas"`
+	// PaymasterVerificationGasLimit and PaymasterPostOpGasLimit are the
+	// v0.7 paymaster gas limits, returned by ERC-7769 bundlers.
+	PaymasterVerificationGasLimit *big.Int `json:"paymasterVerificationGasLimit,omitempty"`
+	PaymasterPostOpGasLimit       *big.Int `json:"paymasterPostOpGasLimit,omitempty"`
+
+	// Extra holds the fields of the bundler's response not covered above,
+	// such as vendor-specific fee or gas hints.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// resolveEstimateAliases fills verificationGasLimit and its historical name
+// verificationGas from each other, so callers can read either.
+func resolveEstimateAliases(e *GasEstimates) {
+	if e.VerificationGasLimit == nil {
+		e.VerificationGasLimit = e.VerificationGas
+	}
+	if e.VerificationGas == nil {
+		e.VerificationGas = e.VerificationGasLimit
+	}
+}
+
+// TransactionReceipt is the receipt of the bundle transaction an op was
+// included in, as embedded in a UserOperationReceipt.
+type TransactionReceipt struct {
+	BlockHash         common.Hash    `json:"blockHash"`
+	BlockNumber       string         `json:"blockNumber"`
+	From              common.Address `json:"from"`
+	CumulativeGasUsed string         `json:"cumulativeGasUsed"`
+	GasUsed           string         `json:"gasUsed"`
+	Logs              []*types.Log   `json:"logs"`
+	LogsBloom         types.Bloom    `json:"logsBloom"`
+	TransactionHash   common.Hash    `json:"transactionHash"`
+	TransactionIndex  string         `json:"transactionIndex"`
+	EffectiveGasPrice string         `json:"effectiveGasPrice"`
+}
+
+// UserOperationReceipt is the result of eth_getUserOperationReceipt.
+// Quantities are kept as returned by the bundler; Event carries them decoded.
+// Logs are the logs emitted by the op's execution.
+type UserOperationReceipt struct {
+	UserOpHash    common.Hash    `json:"userOpHash"`
+	EntryPoint    common.Address `json:"entryPoint"`
+	Sender        common.Address `json:"sender"`
+	Paymaster     common.Address `json:"paymaster"`
+	Nonce         string         `json:"nonce"`
+	Success       bool           `json:"success"`
+	ActualGasCost string         `json:"actualGasCost"`
+	ActualGasUsed string         `json:"actualGasUsed"`
+	From          common.Address `json:"from"`
+	// Reason is the revert data of a failed op, returned by ERC-7769
+	// bundlers.
+	Reason  hexutil.Bytes       `json:"reason,omitempty"`
+	Receipt *TransactionReceipt `json:"receipt"`
+	Logs    []*types.Log        `json:"logs"`
+
+	// Event is the op's UserOperationEvent, and RevertReason,
+	// AccountDeployed and PostOpRevertReason its other EntryPoint events, if
+	// any. They are decoded from the logs by DecodeEvents, and nil if the
+	// bundler did not return the log.
+	Event              *UserOperationEvent        `json:"-"`
+	RevertReason       *UserOperationRevertReason `json:"-"`
+	AccountDeployed    *AccountDeployed           `json:"-"`
+	PostOpRevertReason *PostOpRevertReason        `json:"-"`
+
+	// Extra holds the fields of the bundler's response not covered above.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON accepts the variants returned by different bundlers:
+// numbers as hex strings, decimal strings or JSON numbers, callGas and
+// verificationGas as the pre-v0.6 names of callGasLimit and
+// verificationGasLimit, and the v0.7 paymaster limits. Fields it does not
+// know are kept in Extra.
+func (e *GasEstimates) UnmarshalJSON(input []byte) error {
+	var dec struct {
+		PreVerificationGas            *TolerantBig `json:"preVerificationGas"`
+		VerificationGasLimit          *TolerantBig `json:"verificationGasLimit"`
+		CallGasLimit                  *TolerantBig `json:"callGasLimit"`
+		VerificationGas               *TolerantBig `json:"verificationGas"`
+		CallGas                       *TolerantBig `json:"callGas"`
+		PaymasterVerificationGasLimit *TolerantBig `json:"paymasterVerificationGasLimit"`
+		PaymasterPostOpGasLimit       *TolerantBig `json:"paymasterPostOpGasLimit"`
+	}
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	*e = GasEstimates{
+		PreVerificationGas:            dec.PreVerificationGas.ToInt(),
+		VerificationGasLimit:          dec.VerificationGasLimit.ToInt(),
+		CallGasLimit:                  dec.CallGasLimit.ToInt(),
+		VerificationGas:               dec.VerificationGas.ToInt(),
+		PaymasterVerificationGasLimit: dec.PaymasterVerificationGasLimit.ToInt(),
+		PaymasterPostOpGasLimit:       dec.PaymasterPostOpGasLimit.ToInt(),
+		Extra:                         unknownFields(input, &dec),
+	}
+	if e.CallGasLimit == nil {
+		e.CallGasLimit = dec.CallGas.ToInt()
+	}
+	resolveEstimateAliases(e)
+	return nil
+}
+
+func (r *UserOperationReceipt) UnmarshalJSON(input []byte) error {
+	type receipt UserOperationReceipt
+	if err := json.Unmarshal(input, (*receipt)(r)); err != nil {
+		return err
+	}
+	r.Extra = unknownFields(input, r)
+	return nil
+}
+
+// HashLookupResult is the result of eth_getUserOperationByHash.
+type HashLookupResult struct {
+	UserOperation   *UserOperation `json:"userOperation"`
+	EntryPoint      string         `json:"entryPoint"`
+	BlockNumber     *hexutil.Big   `json:"blockNumber"`
+	BlockHash       common.Hash    `json:"blockHash"`
+	TransactionHash common.Hash    `json:"transactionHash"`
+	// Aggregator is the signature aggregator the op was bundled with, if
+	// the bundler reports it.
+	Aggregator *common.Address `json:"aggregator,omitempty"`
+}
+
+// unknownFields returns the members of the JSON object input that do not
+// map to a field of the struct v points to, or nil if there are none.
+func unknownFields(input []byte, v interface{}) map[string]json.RawMessage {
+	var fields map[string]json.RawMessage
+	if json.Unmarshal(input, &fields) != nil {
+		return nil
+	}
+	known := make(map[string]bool)
+	collectJSONNames(reflect.TypeOf(v).Elem(), known)
+	for k := range fields {
+		// encoding/json matches names case-insensitively.
+		if known[strings.ToLower(k)] {
+			delete(fields, k)
+		}
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	return fields
+}
+
+func collectJSONNames(t reflect.Type, names map[string]bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct {
+			collectJSONNames(f.Type, names)
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		names[strings.ToLower(name)] = true
+	}
+}
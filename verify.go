@@ -0,0 +1,105 @@
+package bundler_client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var ErrEntryPointNotDeployed = errors.New("entrypoint has no code")
+
+// CodeReader is satisfied by *ethclient.Client.
+type CodeReader interface {
+	CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error)
+}
+
+// EntryPointCodeError reports an entrypoint whose deployed code does not
+// match the expected code hash.
+type EntryPointCodeError struct {
+	EntryPoint common.Address
+	Expected   common.Hash
+	Actual     common.Hash
+}
+
+func (e *EntryPointCodeError) Error() string {
+	return fmt.Sprintf("entrypoint %s code hash %s, expected %s", e.EntryPoint, e.Actual, e.Expected)
+}
+
+// EntryPointVerifier checks, once per address, that an entrypoint is deployed
+// on the node's chain and (if listed in CodeHashes) that its runtime code
+// hash matches the expected value for its version. ReferenceCodeHashes
+// reads the expected hashes from a chain the reference deployments are
+// known to be on.
+type EntryPointVerifier struct {
+	Backend    CodeReader
+	CodeHashes map[common.Address]common.Hash
+
+	verified sync.Map
+}
+
+func NewEntryPointVerifier(backend CodeReader, codeHashes map[common.Address]common.Hash) *EntryPointVerifier {
+	return &EntryPointVerifier{Backend: backend, CodeHashes: codeHashes}
+}
+
+// ReferenceCodeHashes returns the runtime code hashes of addresses on the
+// chain behind reference, typically the canonical EntryPoints on Ethereum
+// mainnet, for use as EntryPointVerifier.CodeHashes. The canonical
+// deployments share their addresses and code across chains, so their hashes
+// carry over.
+func ReferenceCodeHashes(ctx context.Context, reference CodeReader, addresses ...common.Address) (map[common.Address]common.Hash, error) {
+	hashes := make(map[common.Address]common.Hash, len(addresses))
+	for _, address := range addresses {
+		code, err := reference.CodeAt(ctx, address, nil)
+		if err != nil {
+			return nil, err
+		}
+		if len(code) == 0 {
+			return nil, fmt.Errorf("%w: %s", ErrEntryPointNotDeployed, address)
+		}
+		hashes[address] = crypto.Keccak256Hash(code)
+	}
+	return hashes, nil
+}
+
+func (v *EntryPointVerifier) Verify(ctx context.Context, entryPoint common.Address) error {
+	if _, ok := v.verified.Load(entryPoint); ok {
+		return nil
+	}
+	code, err := v.Backend.CodeAt(ctx, entryPoint, nil)
+	if err != nil {
+		return err
+	}
+	if len(code) == 0 {
+		return fmt.Errorf("%w: %s", ErrEntryPointNotDeployed, entryPoint)
+	}
+	if expected, ok := v.CodeHashes[entryPoint]; ok {
+		if actual := crypto.Keccak256Hash(code); actual != expected {
+			return &EntryPointCodeError{EntryPoint: entryPoint, Expected: expected, Actual: actual}
+		}
+	}
+	v.verified.Store(entryPoint, struct{}{})
+	return nil
+}
+
+type verifyingClient struct {
+	Client
+	v *EntryPointVerifier
+}
+
+// NewVerifyingClient wraps c so that the entrypoint is verified before the
+// first send to it, failing fast on misconfigured deployments.
+func NewVerifyingClient(c Client, v *EntryPointVerifier) Client {
+	return &verifyingClient{Client: c, v: v}
+}
+
+func (c *verifyingClient) SendUserOperation(ctx context.Context, op *UserOperation, entryPoint common.Address) (common.Hash, error) {
+	if err := c.v.Verify(ctx, entryPoint); err != nil {
+		return common.Hash{}, err
+	}
+	return c.Client.SendUserOperation(ctx, op, entryPoint)
+}
@@ -0,0 +1,205 @@
+package bundler_client
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type OpStatus string
+
+const (
+	OpStatusPending  OpStatus = "pending"
+	OpStatusIncluded OpStatus = "included"
+	OpStatusReverted OpStatus = "reverted"
+	OpStatusDropped  OpStatus = "dropped"
+	OpStatusReplaced OpStatus = "replaced"
+	OpStatusUnknown  OpStatus = "unknown"
+)
+
+// OpTransition reports a change in the status of a watched op. Receipt is
+// set for included and reverted ops.
+type OpTransition struct {
+	UserOpHash common.Hash
+	Sender     common.Address
+	Nonce      *big.Int
+	Status     OpStatus
+	Receipt    *UserOperationReceipt
+	Time       time.Time
+}
+
+// SenderWatcher polls the bundler mempool for a sender's ops and their
+// receipts, delivering status transitions on Transitions. It requires the
+// bundler to expose debug_bundler_dumpMempool, and is a Service.
+//
+// The hashes of ops found in the mempool are only computed for v0.6
+// EntryPoints, as the mempool is dumped in the v0.6 format. For v0.7 and
+// v0.8 EntryPoints, register the hash returned at send time with Add; such
+// ops are matched to the mempool by nonce.
+type SenderWatcher struct {
+	loop
+
+	client     Client
+	sender     common.Address
+	entryPoint common.Address
+	interval   time.Duration
+	// Version is the EntryPoint's version, which determines how op hashes
+	// are computed. NewSenderWatcher sets it for the canonical EntryPoints
+	// and defaults to v0.6 for others.
+	Version EntryPointVersion
+
+	transitions chan OpTransition
+	pending     map[common.Hash]*watchedOp
+	addedMu     sync.Mutex
+	added       []*watchedOp
+	OnError     func(error)
+	// DropAfter is how long an op may be absent from both the mempool and
+	// the receipts before it is reported as dropped. Ops leave the mempool
+	// as soon as they are bundled, before a receipt exists.
+	DropAfter time.Duration
+}
+
+type watchedOp struct {
+	OpTransition
+	missingSince time.Time
+}
+
+func NewSenderWatcher(client Client, sender, entryPoint common.Address, interval time.Duration) *SenderWatcher {
+	version, ok := EntryPointVersionOf(entryPoint)
+	if !ok {
+		version = EntryPointVersion06
+	}
+	w := &SenderWatcher{
+		client:      client,
+		sender:      sender,
+		entryPoint:  entryPoint,
+		interval:    interval,
+		Version:     version,
+		transitions: make(chan OpTransition, 64),
+		pending:     make(map[common.Hash]*watchedOp),
+		DropAfter:   time.Minute,
+	}
+	w.run = w.watch
+	return w
+}
+
+// Add starts watching the op the sender sent with the given nonce, under the
+// hash returned by the bundler. It is required to watch ops sent to v0.7 and
+// v0.8 EntryPoints.
+func (w *SenderWatcher) Add(hash common.Hash, nonce *big.Int) {
+	w.addedMu.Lock()
+	defer w.addedMu.Unlock()
+	w.added = append(w.added, &watchedOp{OpTransition: OpTransition{UserOpHash: hash, Sender: w.sender, Nonce: nonce, Status: OpStatusPending}})
+}
+
+// Transitions returns the channel of status transitions. Transitions are
+// dropped if the channel is not drained.
+func (w *SenderWatcher) Transitions() <-chan OpTransition {
+	return w.transitions
+}
+
+func (w *SenderWatcher) emit(t OpTransition) {
+	t.Time = time.Now()
+	select {
+	case w.transitions <- t:
+	default:
+	}
+}
+
+func (w *SenderWatcher) watch(ctx context.Context) {
+	chainId, err := w.client.ChainId(ctx)
+	if err != nil {
+		w.fail(ctx, err)
+		return
+	}
+	t := time.NewTicker(w.interval)
+	defer t.Stop()
+	for {
+		w.poll(ctx, chainId)
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+func (w *SenderWatcher) fail(ctx context.Context, err error) {
+	if ctx.Err() == nil && w.OnError != nil {
+		w.OnError(err)
+	}
+}
+
+func (w *SenderWatcher) poll(ctx context.Context, chainId *big.Int) {
+	ops, err := w.client.BundlerDumpMempool(ctx, w.entryPoint)
+	if err != nil {
+		w.fail(ctx, err)
+		return
+	}
+	w.addedMu.Lock()
+	added := w.added
+	w.added = nil
+	w.addedMu.Unlock()
+	for _, p := range added {
+		if _, ok := w.pending[p.UserOpHash]; !ok {
+			w.pending[p.UserOpHash] = p
+			w.emit(p.OpTransition)
+		}
+	}
+
+	inPool := make(map[common.Hash]bool)
+	for _, op := range ops {
+		if op.Sender != w.sender {
+			continue
+		}
+		if w.Version != EntryPointVersion06 {
+			for hash, p := range w.pending {
+				if p.Nonce != nil && op.Nonce != nil && p.Nonce.Cmp(op.Nonce) == 0 {
+					inPool[hash] = true
+					p.missingSince = time.Time{}
+				}
+			}
+			continue
+		}
+		hash := op.GetUserOpHash(w.entryPoint, chainId)
+		inPool[hash] = true
+		if p, ok := w.pending[hash]; ok {
+			p.missingSince = time.Time{}
+			continue
+		}
+		p := &watchedOp{OpTransition: OpTransition{UserOpHash: hash, Sender: op.Sender, Nonce: op.Nonce, Status: OpStatusPending}}
+		w.pending[hash] = p
+		w.emit(p.OpTransition)
+	}
+	for hash, p := range w.pending {
+		if inPool[hash] {
+			continue
+		}
+		receipt, err := w.client.GetUserOperationReceipt(ctx, hash)
+		if err != nil {
+			w.fail(ctx, err)
+			continue
+		}
+		t := p.OpTransition
+		if receipt == nil || receipt.UserOpHash != hash {
+			if p.missingSince.IsZero() {
+				p.missingSince = time.Now()
+			} else if time.Since(p.missingSince) >= w.DropAfter {
+				delete(w.pending, hash)
+				t.Status = OpStatusDropped
+				w.emit(t)
+			}
+			continue
+		}
+		delete(w.pending, hash)
+		t.Status = OpStatusIncluded
+		if !receipt.Success {
+			t.Status = OpStatusReverted
+		}
+		t.Receipt = receipt
+		w.emit(t)
+	}
+}